@@ -0,0 +1,53 @@
+package dayxx
+
+import (
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(0, solver.NewParsed(parseNumbers, sum, count))
+}
+
+func part1(input string) (int, error) {
+	numbers, err := parseNumbers(input)
+	if err != nil {
+		return 0, err
+	}
+	return sum(numbers)
+}
+
+func part2(input string) (int, error) {
+	numbers, err := parseNumbers(input)
+	if err != nil {
+		return 0, err
+	}
+	return count(numbers)
+}
+
+// sum and count must not modify the parsed numbers, which the bench
+// command reuses for every run.
+func sum(numbers []int) (int, error) {
+	sum := 0
+	for _, n := range numbers {
+		sum += n
+	}
+	return sum, nil
+}
+
+func count(numbers []int) (int, error) {
+	return len(numbers), nil
+}
+
+func parseNumbers(input string) ([]int, error) {
+	lines := inputs.Lines(input)
+	numbers := make([]int, len(lines))
+	for i, line := range lines {
+		var err error
+		numbers[i], err = line.Int()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return numbers, nil
+}
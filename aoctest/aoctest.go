@@ -0,0 +1,142 @@
+// Package aoctest runs the example and golden-answer fixtures of a day as tests.
+//
+// Each day keeps its fixtures in testdata/fixtures.json, a list of cases:
+//
+//	[
+//	  {"input": "example1.txt", "part": 1, "want": 142},
+//	  {"input": "example.txt", "part": 2, "params": {"steps": 6}, "want": 16},
+//	  {"part": 1, "want": 54953}
+//	]
+//
+// input names a file in testdata. Cases without input are golden answers for
+// the real puzzle input, which is looked up like the aoc command does and the
+// case is skipped when it is absent. Cases for malformed input give the
+// expected error message instead of want:
+//
+//	{"input": "malformed.txt", "part": 1, "error": "line 2, column 6: invalid number \"x\""}
+package aoctest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/maps"
+
+	"aoc2023/inputs"
+)
+
+// Case is a single fixture.
+type Case struct {
+	Input  string         `json:"input"`
+	Part   int            `json:"part"`
+	Params map[string]int `json:"params"`
+	Want   json.Number    `json:"want"`
+	Error  string         `json:"error"`
+}
+
+// Name identifies the case in test output.
+func (c Case) Name() string {
+	input := c.Input
+	if input == "" {
+		input = "input"
+	}
+	name := fmt.Sprintf("part%d/%s", c.Part, strings.TrimSuffix(input, ".txt"))
+	keys := maps.Keys(c.Params)
+	slices.Sort(keys)
+	for _, key := range keys {
+		name += fmt.Sprintf("/%s=%d", key, c.Params[key])
+	}
+	return name
+}
+
+// Param returns the named parameter; it panics if the case does not define it.
+func (c Case) Param(name string) int {
+	value, ok := c.Params[name]
+	if !ok {
+		panic(fmt.Sprintf("aoctest: case %s has no parameter %q", c.Name(), name))
+	}
+	return value
+}
+
+// SolveFunc computes the answer of a case for the given puzzle text.
+type SolveFunc func(c Case, input string) (any, error)
+
+// Parts returns a SolveFunc for days whose parts need no parameters.
+func Parts[T any](part1, part2 func(input string) (T, error)) SolveFunc {
+	return func(c Case, input string) (any, error) {
+		if c.Part == 1 {
+			return part1(input)
+		}
+		return part2(input)
+	}
+}
+
+// Load reads testdata/fixtures.json of the package under test.
+func Load(t *testing.T) []Case {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join("testdata", "fixtures.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []Case{}
+	if err := json.Unmarshal(content, &cases); err != nil {
+		t.Fatalf("testdata/fixtures.json: %v", err)
+	}
+	return cases
+}
+
+// Run runs every fixture of the given day as a subtest.
+func Run(t *testing.T, day int, solve SolveFunc) {
+	t.Helper()
+	for _, c := range Load(t) {
+		c := c
+		t.Run(c.Name(), func(t *testing.T) {
+			input := readInput(t, day, c)
+			got, err := solve(c, input)
+			if c.Error != "" {
+				if err == nil || err.Error() != c.Error {
+					t.Errorf("got error %v, want %s", err, c.Error)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprint(got) != c.Want.String() {
+				t.Errorf("got %v, want %s", got, c.Want)
+			}
+		})
+	}
+}
+
+func readInput(t *testing.T, day int, c Case) string {
+	t.Helper()
+	if c.Input != "" {
+		content, err := os.ReadFile(filepath.Join("testdata", c.Input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return strings.TrimRight(string(content), "\n")
+	}
+
+	// tests run inside the day's directory, so the default inputs directory is its parent
+	dir := ".."
+	if os.Getenv(inputs.EnvDir) != "" {
+		dir = inputs.DefaultDir()
+	}
+	input, err := inputs.Load(day, "", dir)
+	notFound := &inputs.NotFoundError{}
+	if errors.As(err, &notFound) {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return input
+}
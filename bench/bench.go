@@ -0,0 +1,220 @@
+// Package bench measures how long the solvers take and how much they
+// allocate, and compares the results against a saved baseline.
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"runtime"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"aoc2023/solver"
+)
+
+// Result is the measurement of one part of one day over several runs.
+type Result struct {
+	Day    int    `json:"day"`
+	Part   int    `json:"part"`
+	Answer string `json:"answer"`
+	Runs   int    `json:"runs"`
+	// Parse is nil if the solver does not parse separately; its parsing
+	// is then included in Solve.
+	Parse *Stats `json:"parse,omitempty"`
+	Solve Stats  `json:"solve"`
+	// Allocs and Bytes are allocated per run, parsing included.
+	Allocs uint64 `json:"allocs"`
+	Bytes  uint64 `json:"bytes"`
+}
+
+// Total returns the median time of parsing and solving.
+func (r Result) Total() time.Duration {
+	if r.Parse == nil {
+		return r.Solve.Median
+	}
+	return r.Parse.Median + r.Solve.Median
+}
+
+// Stats summarizes the durations of repeated runs.
+type Stats struct {
+	Min    time.Duration `json:"min_ns"`
+	Median time.Duration `json:"median_ns"`
+	P95    time.Duration `json:"p95_ns"`
+}
+
+func newStats(durations []time.Duration) Stats {
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+	return Stats{
+		Min:    sorted[0],
+		Median: percentile(sorted, 0.5),
+		P95:    percentile(sorted, 0.95),
+	}
+}
+
+// percentile returns the nearest-rank percentile of sorted durations.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+// Measure solves a part runs times. Parsing is timed on its own if s is a
+// solver.Parser. It stops at the first error of the solver.
+func Measure(day int, s solver.Solver, part int, input string, runs int) (Result, error) {
+	if runs < 1 {
+		runs = 1
+	}
+	parser, separate := s.(solver.Parser)
+	parseTimes := make([]time.Duration, runs)
+	solveTimes := make([]time.Duration, runs)
+	var answer any
+	var err error
+
+	runtime.GC()
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for i := 0; i < runs; i++ {
+		start := time.Now()
+		if separate {
+			var parsed any
+			parsed, err = parser.Parse(input)
+			parseTimes[i] = time.Since(start)
+			start = time.Now()
+			if err == nil {
+				answer, err = parser.SolveParsed(part, parsed)
+			}
+		} else if part == 1 {
+			answer, err = s.Part1(input)
+		} else {
+			answer, err = s.Part2(input)
+		}
+		solveTimes[i] = time.Since(start)
+		if err != nil {
+			return Result{}, err
+		}
+	}
+	runtime.ReadMemStats(&after)
+
+	result := Result{
+		Day:    day,
+		Part:   part,
+		Answer: fmt.Sprint(answer),
+		Runs:   runs,
+		Solve:  newStats(solveTimes),
+		Allocs: (after.Mallocs - before.Mallocs) / uint64(runs),
+		Bytes:  (after.TotalAlloc - before.TotalAlloc) / uint64(runs),
+	}
+	if separate {
+		parseStats := newStats(parseTimes)
+		result.Parse = &parseStats
+	}
+	return result, nil
+}
+
+// WriteTable writes the results as an aligned table.
+func WriteTable(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "day\tpart\tparse\tsolve\tp95\tmin\tallocs\tbytes\tanswer\t")
+	for _, r := range results {
+		parse := "-"
+		if r.Parse != nil {
+			parse = round(r.Parse.Median).String()
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\t%s\t%d\t%d\t%s\t\n",
+			r.Day, r.Part, parse, round(r.Solve.Median), round(r.Solve.P95), round(r.Solve.Min),
+			r.Allocs, r.Bytes, r.Answer)
+	}
+	return tw.Flush()
+}
+
+// WriteJSON writes the results in the format read by ReadBaseline.
+func WriteJSON(w io.Writer, results []Result) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(results)
+}
+
+// ReadBaseline reads results written by WriteJSON.
+func ReadBaseline(r io.Reader) ([]Result, error) {
+	results := []Result{}
+	if err := json.NewDecoder(r).Decode(&results); err != nil {
+		return nil, fmt.Errorf("bench: reading baseline: %w", err)
+	}
+	return results, nil
+}
+
+// Delta compares the total median time of a part with its baseline.
+type Delta struct {
+	Day, Part         int
+	Baseline, Current time.Duration
+	// Change is the relative change of the time, 0.1 meaning 10% slower.
+	Change float64
+	// Regression is set if the part got slower than the threshold allows.
+	Regression bool
+	// AnswerChanged is set if the part no longer returns the baseline answer.
+	AnswerChanged bool
+}
+
+// Compare matches the current results with the baseline by day and part.
+// Parts missing from the baseline are left out.
+func Compare(baseline, current []Result, threshold float64) []Delta {
+	type key struct{ day, part int }
+	base := make(map[key]Result, len(baseline))
+	for _, r := range baseline {
+		base[key{r.Day, r.Part}] = r
+	}
+
+	deltas := []Delta{}
+	for _, r := range current {
+		b, ok := base[key{r.Day, r.Part}]
+		if !ok {
+			continue
+		}
+		delta := Delta{
+			Day:           r.Day,
+			Part:          r.Part,
+			Baseline:      b.Total(),
+			Current:       r.Total(),
+			AnswerChanged: b.Answer != r.Answer,
+		}
+		if delta.Baseline > 0 {
+			delta.Change = float64(delta.Current-delta.Baseline) / float64(delta.Baseline)
+		}
+		delta.Regression = delta.Change > threshold
+		deltas = append(deltas, delta)
+	}
+	return deltas
+}
+
+// WriteComparison writes the deltas as an aligned table.
+func WriteComparison(w io.Writer, deltas []Delta) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "day\tpart\tbaseline\tcurrent\tchange\t\t")
+	for _, d := range deltas {
+		note := ""
+		switch {
+		case d.AnswerChanged:
+			note = "ANSWER CHANGED"
+		case d.Regression:
+			note = "REGRESSION"
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%+.1f%%\t%s\t\n",
+			d.Day, d.Part, round(d.Baseline), round(d.Current), 100*d.Change, note)
+	}
+	return tw.Flush()
+}
+
+// round keeps durations readable in tables.
+func round(d time.Duration) time.Duration {
+	switch {
+	case d >= time.Second:
+		return d.Round(time.Millisecond)
+	case d >= time.Millisecond:
+		return d.Round(time.Microsecond)
+	default:
+		return d
+	}
+}
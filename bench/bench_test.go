@@ -0,0 +1,78 @@
+package bench
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"aoc2023/solver"
+)
+
+func TestPercentile(t *testing.T) {
+	durations := []time.Duration{}
+	for i := 20; i >= 1; i-- {
+		durations = append(durations, time.Duration(i))
+	}
+	stats := newStats(durations)
+	if stats.Min != 1 || stats.Median != 10 || stats.P95 != 19 {
+		t.Errorf("newStats = %+v, want min 1, median 10, p95 19", stats)
+	}
+}
+
+func TestMeasure(t *testing.T) {
+	parsed := solver.NewParsed(func(input string) (int, error) { return len(input), nil },
+		func(n int) (int, error) { return n + 1, nil },
+		func(n int) (int, error) { return n + 2, nil })
+	plain := solver.New(func(input string) (int, error) { return 1, nil },
+		func(input string) (int, error) { return 0, errors.New("unsolved") })
+
+	r, err := Measure(1, parsed, 2, "abc", 5)
+	if err != nil || r.Answer != "5" || r.Runs != 5 || r.Parse == nil {
+		t.Errorf("Measure(parsed) = %+v, %v; want answer 5 over 5 runs with parse stats", r, err)
+	}
+	r, err = Measure(1, plain, 1, "abc", 0)
+	if err != nil || r.Answer != "1" || r.Runs != 1 || r.Parse != nil {
+		t.Errorf("Measure(plain) = %+v, %v; want answer 1 in 1 run without parse stats", r, err)
+	}
+	if _, err := Measure(1, plain, 2, "abc", 3); err == nil {
+		t.Error("Measure(plain) of failing part returned no error")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	baseline := []Result{
+		{Day: 1, Part: 1, Answer: "7", Solve: Stats{Median: 100}},
+		{Day: 1, Part: 2, Answer: "8", Parse: &Stats{Median: 50}, Solve: Stats{Median: 50}},
+		{Day: 2, Part: 1, Answer: "9", Solve: Stats{Median: 100}},
+	}
+	current := []Result{
+		{Day: 1, Part: 1, Answer: "7", Solve: Stats{Median: 105}},
+		{Day: 1, Part: 2, Answer: "8", Parse: &Stats{Median: 50}, Solve: Stats{Median: 100}},
+		{Day: 2, Part: 1, Answer: "10", Solve: Stats{Median: 50}},
+		{Day: 3, Part: 1, Answer: "1", Solve: Stats{Median: 50}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, baseline); err != nil {
+		t.Fatal(err)
+	}
+	read, err := ReadBaseline(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deltas := Compare(read, current, 0.1)
+	if len(deltas) != 3 {
+		t.Fatalf("Compare returned %d deltas, want 3", len(deltas))
+	}
+	if deltas[0].Regression || deltas[0].AnswerChanged {
+		t.Errorf("5%% slower: got %+v, want no regression", deltas[0])
+	}
+	if !deltas[1].Regression || deltas[1].Change != 0.5 {
+		t.Errorf("50%% slower: got %+v, want regression", deltas[1])
+	}
+	if deltas[2].Regression || !deltas[2].AnswerChanged {
+		t.Errorf("different answer: got %+v, want answer changed", deltas[2])
+	}
+}
@@ -0,0 +1,103 @@
+// Command almanac queries the almanac of day 5 as a single function from
+// seeds to locations.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"aoc2023/day05"
+	"aoc2023/inputs"
+)
+
+const usage = `usage: almanac <command> [flags]
+
+commands:
+  compose                  print the pieces of the composed seed-to-location function
+                           and its breakpoints, the seeds where a new piece starts
+  lookup --location <n>    print all seeds that land on location n
+
+flags:
+  --input <file>           read the almanac from file ("-" for stdin)
+  --inputs-dir <dir>       look up day05/input.txt or day05.txt in dir
+                           (default $AOC_INPUTS or the current directory)
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "compose":
+		err = compose(os.Args[2:])
+	case "lookup":
+		err = lookup(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		inputs.Report(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func compose(args []string) error {
+	fs := flag.NewFlagSet("compose", flag.ExitOnError)
+	load := inputFlags(fs)
+	fs.Parse(args)
+	almanac, err := load()
+	if err != nil {
+		return err
+	}
+
+	f := almanac.Compose()
+	fmt.Printf("%s: %d pieces\n", strings.Join(almanac.Names(), ", "), len(f))
+	fmt.Print(f)
+	fmt.Printf("breakpoints: %s\n", strings.Trim(fmt.Sprint(f.Breakpoints()), "[]"))
+	return nil
+}
+
+func lookup(args []string) error {
+	fs := flag.NewFlagSet("lookup", flag.ExitOnError)
+	load := inputFlags(fs)
+	location := fs.Int("location", -1, "location to find the seeds of")
+	fs.Parse(args)
+	if *location < 0 {
+		return errors.New("lookup: --location must be given and not negative")
+	}
+	almanac, err := load()
+	if err != nil {
+		return err
+	}
+
+	seeds := almanac.Compose().Seeds(*location)
+	if len(seeds) == 0 {
+		fmt.Printf("no seed lands on location %d\n", *location)
+	}
+	for _, seed := range seeds {
+		fmt.Printf("seed %d -> location %d\n", seed, *location)
+	}
+	return nil
+}
+
+// inputFlags defines the input flags and returns a function that loads the
+// almanac they select.
+func inputFlags(fs *flag.FlagSet) func() (day05.Almanac, error) {
+	inputPath := fs.String("input", "", "almanac file, \"-\" for stdin")
+	inputsDir := fs.String("inputs-dir", inputs.DefaultDir(), "directory containing the puzzle inputs")
+	return func() (day05.Almanac, error) {
+		input, err := inputs.Load(5, *inputPath, *inputsDir)
+		if err != nil {
+			return day05.Almanac{}, err
+		}
+		almanac, _, err := day05.ParseAlmanac(input)
+		return almanac, err
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"aoc2023/bench"
+	"aoc2023/solver"
+)
+
+func runBench(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	sel := selectionFlags(fs)
+	runs := fs.Int("runs", 10, "number of times every part is solved")
+	format := fs.String("format", "table", "output format: table or json")
+	baselinePath := fs.String("compare", "", "baseline file saved with --format json")
+	threshold := fs.Float64("threshold", 10, "slowdown in percent reported as regression")
+	if err := sel.parse(fs, args); err != nil {
+		return err
+	}
+	if *runs < 1 {
+		return fmt.Errorf("bench: invalid number of runs %d", *runs)
+	}
+	if *format != "table" && *format != "json" {
+		return fmt.Errorf("bench: invalid format %q", *format)
+	}
+
+	var baseline []bench.Result
+	if *baselinePath != "" {
+		f, err := os.Open(*baselinePath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		baseline, err = bench.ReadBaseline(f)
+		if err != nil {
+			return err
+		}
+	}
+
+	results := []bench.Result{}
+	err := sel.each(func(day int, s solver.Solver, input string) error {
+		for _, part := range sel.parts() {
+			result, err := bench.Measure(day, s, part, input, *runs)
+			if err != nil {
+				return fmt.Errorf("day %d part %d: %w", day, part, err)
+			}
+			results = append(results, result)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// keep the JSON on stdout parseable, so it can be saved as the next baseline
+	comparison := io.Writer(os.Stdout)
+	if *format == "json" {
+		err = bench.WriteJSON(os.Stdout, results)
+		comparison = os.Stderr
+	} else {
+		err = bench.WriteTable(os.Stdout, results)
+	}
+	if err != nil || baseline == nil {
+		return err
+	}
+
+	fmt.Fprintln(comparison)
+	deltas := bench.Compare(baseline, results, *threshold/100)
+	if err := bench.WriteComparison(comparison, deltas); err != nil {
+		return err
+	}
+	regressions := 0
+	for _, d := range deltas {
+		if d.Regression || d.AnswerChanged {
+			regressions++
+		}
+	}
+	if regressions > 0 {
+		return fmt.Errorf("bench: %d of %d parts regressed against %s", regressions, len(deltas), *baselinePath)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package main
+
+// every day registers its solver on import
+import (
+	_ "aoc2023/day01"
+	_ "aoc2023/day02"
+	_ "aoc2023/day03"
+	_ "aoc2023/day04"
+	_ "aoc2023/day05"
+	_ "aoc2023/day06"
+	_ "aoc2023/day07"
+	_ "aoc2023/day08"
+	_ "aoc2023/day09"
+	_ "aoc2023/day10"
+	_ "aoc2023/day11"
+	_ "aoc2023/day12"
+	_ "aoc2023/day13"
+	_ "aoc2023/day14"
+	_ "aoc2023/day15"
+	_ "aoc2023/day16"
+	_ "aoc2023/day17"
+	_ "aoc2023/day18"
+	_ "aoc2023/day19"
+	_ "aoc2023/day20"
+	_ "aoc2023/day21"
+	_ "aoc2023/day22"
+	_ "aoc2023/day23"
+	_ "aoc2023/day24"
+)
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"aoc2023/aoctest"
+	"aoc2023/solver"
+)
+
+// TestParsers checks that every day parses separately, so bench can time
+// parsing on its own, and that solving leaves the parsed input unchanged:
+// solving the same parsed example twice must give the fixture's answer both times.
+func TestParsers(t *testing.T) {
+	for _, day := range solver.Days() {
+		s, _ := solver.Get(day)
+		parser, ok := s.(solver.Parser)
+		if !ok {
+			t.Errorf("day %d is not registered with solver.NewParsed", day)
+			continue
+		}
+
+		dir := filepath.Join("..", "..", fmt.Sprintf("day%02d", day), "testdata")
+		content, err := os.ReadFile(filepath.Join(dir, "fixtures.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		cases := []aoctest.Case{}
+		if err := json.Unmarshal(content, &cases); err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range cases {
+			// parameters differ from the real puzzle the solver is registered for
+			if c.Input == "" || c.Error != "" || len(c.Params) > 0 {
+				continue
+			}
+			input, err := os.ReadFile(filepath.Join(dir, c.Input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsed, err := parser.Parse(strings.TrimRight(string(input), "\n"))
+			if err != nil {
+				t.Fatalf("day %d %s: %v", day, c.Name(), err)
+			}
+			for run := 1; run <= 2; run++ {
+				got, err := parser.SolveParsed(c.Part, parsed)
+				if err != nil {
+					t.Fatalf("day %d %s run %d: %v", day, c.Name(), run, err)
+				}
+				if fmt.Sprint(got) != c.Want.String() {
+					t.Errorf("day %d %s run %d: got %v, want %s", day, c.Name(), run, got, c.Want)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+const usage = `usage: aoc <command> [arguments]
+
+commands:
+  run <days> [flags]     solve the given days (e.g. 17, 1-25, all)
+  bench <days> [flags]   measure time and allocations of the given days
+
+run and bench flags:
+  --part 1|2             only solve the given part
+  --input <file>         read the puzzle input from file ("-" for stdin)
+  --inputs-dir <dir>     look up dayNN/input.txt or dayNN.txt in dir
+                         (default $AOC_INPUTS or the current directory)
+
+run flags:
+  --debug                print diagnostic output of the solvers to stderr
+
+bench flags:
+  --runs <n>             solve every part n times (default 10)
+  --format table|json    output format (default table)
+  --compare <file>       compare with a baseline saved with --format json
+  --threshold <percent>  slowdown reported as regression (default 10)
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "run":
+		err = run(os.Args[2:])
+	case "bench":
+		err = runBench(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		inputs.Report(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	sel := selectionFlags(fs)
+	debug := fs.Bool("debug", false, "print diagnostic output of the solvers")
+	if err := sel.parse(fs, args); err != nil {
+		return err
+	}
+	if *debug {
+		solver.Debug = os.Stderr
+	}
+
+	return sel.each(func(day int, s solver.Solver, input string) error {
+		for _, part := range sel.parts() {
+			solve := s.Part1
+			if part == 2 {
+				solve = s.Part2
+			}
+			answer, err := solve(input)
+			if err != nil {
+				return fmt.Errorf("day %d part %d: %w", day, part, err)
+			}
+			fmt.Printf("Day %d Part %d: %v\n", day, part, answer)
+		}
+		return nil
+	})
+}
+
+// selection holds the days, parts and inputs chosen on the command line.
+type selection struct {
+	part      *int
+	inputPath *string
+	inputsDir *string
+	days      []int
+}
+
+func selectionFlags(fs *flag.FlagSet) *selection {
+	return &selection{
+		part:      fs.Int("part", 0, "only solve the given part (1 or 2)"),
+		inputPath: fs.String("input", "", "puzzle input file, \"-\" for stdin"),
+		inputsDir: fs.String("inputs-dir", inputs.DefaultDir(), "directory containing the puzzle inputs"),
+	}
+}
+
+// parse parses the flags and the day specification in args.
+func (sel *selection) parse(fs *flag.FlagSet, args []string) error {
+	positional := parseInterspersed(fs, args)
+	if len(positional) != 1 {
+		return fmt.Errorf("%s: expected exactly one day specification", fs.Name())
+	}
+	if *sel.part < 0 || *sel.part > 2 {
+		return fmt.Errorf("%s: invalid part %d", fs.Name(), *sel.part)
+	}
+	days, err := parseDays(positional[0])
+	if err != nil {
+		return err
+	}
+	if *sel.inputPath != "" && len(days) != 1 {
+		return fmt.Errorf("%s: --input can only be used with a single day", fs.Name())
+	}
+	sel.days = days
+	return nil
+}
+
+// parts returns the selected parts.
+func (sel *selection) parts() []int {
+	if *sel.part != 0 {
+		return []int{*sel.part}
+	}
+	return []int{1, 2}
+}
+
+// each calls f with the solver and input of every selected day until f
+// fails. Days without a solver are skipped, unless a single day was selected.
+func (sel *selection) each(f func(day int, s solver.Solver, input string) error) error {
+	for _, day := range sel.days {
+		s, ok := solver.Get(day)
+		if !ok {
+			if len(sel.days) == 1 {
+				return fmt.Errorf("day %d: no solver registered", day)
+			}
+			continue
+		}
+		input, err := inputs.Load(day, *sel.inputPath, *sel.inputsDir)
+		if err != nil {
+			return err
+		}
+		if err := f(day, s, input); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseInterspersed parses flags that may appear before, between or after
+// positional arguments and returns the positional ones.
+func parseInterspersed(fs *flag.FlagSet, args []string) []string {
+	positional := []string{}
+	for {
+		fs.Parse(args)
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// parseDays parses "all", a single day ("17") or an inclusive range ("1-25").
+func parseDays(s string) ([]int, error) {
+	if s == "all" {
+		return solver.Days(), nil
+	}
+	from, to, isRange := strings.Cut(s, "-")
+	first, err := strconv.Atoi(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid day %q", s)
+	}
+	last := first
+	if isRange {
+		last, err = strconv.Atoi(to)
+		if err != nil || last < first {
+			return nil, fmt.Errorf("invalid day range %q", s)
+		}
+	}
+	days := []int{}
+	for day := first; day <= last; day++ {
+		days = append(days, day)
+	}
+	return days, nil
+}
@@ -0,0 +1,49 @@
+// Command hashmap runs the initialization sequence of day 15 and prints
+// the lenses in the boxes.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"aoc2023/day15"
+	"aoc2023/inputs"
+)
+
+func main() {
+	trace := flag.Bool("trace", false, "print the boxes after every step")
+	inputPath := flag.String("input", "", "initialization sequence file, \"-\" for stdin")
+	inputsDir := flag.String("inputs-dir", inputs.DefaultDir(), "directory containing the puzzle inputs")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: hashmap [flags]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(*inputPath, *inputsDir, *trace); err != nil {
+		inputs.Report(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(inputPath, inputsDir string, trace bool) error {
+	input, err := inputs.Load(15, inputPath, inputsDir)
+	if err != nil {
+		return err
+	}
+	var traceTo io.Writer
+	if trace {
+		traceTo = os.Stdout
+	}
+	lenses, err := day15.Interpret(input, traceTo)
+	if err != nil {
+		return err
+	}
+	if !trace {
+		fmt.Print(lenses)
+	}
+	fmt.Println("Focusing power:", lenses.FocusingPower(func(focalLength int) int { return focalLength }))
+	return nil
+}
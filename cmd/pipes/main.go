@@ -0,0 +1,47 @@
+// Command pipes draws the pipe loop of day 10 and the tiles it encloses.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"aoc2023/day10"
+	"aoc2023/inputs"
+)
+
+func main() {
+	format := flag.String("format", "text", "output format: text, ansi or svg")
+	inputPath := flag.String("input", "", "puzzle input file, \"-\" for stdin")
+	inputsDir := flag.String("inputs-dir", inputs.DefaultDir(), "directory containing the puzzle inputs")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: pipes [flags]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := draw(*format, *inputPath, *inputsDir); err != nil {
+		inputs.Report(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func draw(format, inputPath, inputsDir string) error {
+	input, err := inputs.Load(10, inputPath, inputsDir)
+	if err != nil {
+		return err
+	}
+	loop, err := day10.ParseLoop(input)
+	if err != nil {
+		return err
+	}
+	switch format {
+	case "text":
+		return loop.Render(os.Stdout, false)
+	case "ansi":
+		return loop.Render(os.Stdout, true)
+	case "svg":
+		return loop.SVG(os.Stdout)
+	}
+	return fmt.Errorf("unknown format %q, want text, ansi or svg", format)
+}
@@ -1,43 +0,0 @@
-package main
-
-import (
-	_ "embed"
-	"log"
-	"strings"
-)
-
-//go:embed example1.txt
-var example1 string
-
-//go:embed example2.txt
-var example2 string
-
-//go:embed input.txt
-var input string
-
-func main() {
-	exampleResult1 := part1(example1)
-	if exampleResult1 != 123 {
-		log.Fatalf("Part 1 wrong; acutal: %d\n", exampleResult1)
-	}
-	log.Printf("Part 1: %d\n", part1(input))
-
-	exampleResult2 := part2(example2)
-	if exampleResult2 != 123 {
-		log.Fatalf("Part 2 wrong; acutal: %d\n", exampleResult2)
-	}
-	log.Printf("Part 2: %d\n", part2(input))
-
-}
-
-func part1(input string) int {
-	lines := strings.Split(input, "\n")
-	sum := len(lines)
-	return sum
-}
-
-func part2(input string) int {
-	lines := strings.Split(input, "\n")
-	sum := len(lines)
-	return sum
-}
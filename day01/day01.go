@@ -0,0 +1,104 @@
+package day01
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(1, solver.NewParsed(parseDocument, sumDigits, sumSpelledDigits))
+}
+
+func part1(input string) (int64, error) {
+	lines, err := parseDocument(input)
+	if err != nil {
+		return 0, err
+	}
+	return sumDigits(lines)
+}
+
+func part2(input string) (int64, error) {
+	lines, err := parseDocument(input)
+	if err != nil {
+		return 0, err
+	}
+	return sumSpelledDigits(lines)
+}
+
+// parseDocument splits the calibration document into its lines.
+func parseDocument(input string) ([]inputs.Span, error) {
+	return inputs.Lines(input), nil
+}
+
+func sumDigits(lines []inputs.Span) (int64, error) {
+	sum := int64(0)
+	for _, line := range lines {
+		first := strings.IndexFunc(line.Text, unicode.IsDigit)
+		if first < 0 {
+			return 0, line.Errorf("no digit in")
+		}
+		last := strings.LastIndexFunc(line.Text, unicode.IsDigit)
+		twoDigitNumber := int64(line.Text[first]-'0')*10 + int64(line.Text[last]-'0')
+		sum += twoDigitNumber
+	}
+	return sum, nil
+}
+
+func sumSpelledDigits(lines []inputs.Span) (int64, error) {
+	re := regexp.MustCompile("[0-9]|one|two|three|four|five|six|seven|eight|nine|zero")
+	reReverse := regexp.MustCompile("[0-9]|orez|enin|thgie|neves|xis|evif|ruof|eerht|owt|eno")
+	sum := int64(0)
+	for _, line := range lines {
+		firstDigit := re.FindString(line.Text)
+		if firstDigit == "" {
+			return 0, line.Errorf("no digit in")
+		}
+		lastDigit := Reverse(reReverse.FindString(Reverse(line.Text)))
+		twoDigitNumber, err := strconv.ParseInt(ReplaceLetters(firstDigit)+ReplaceLetters(lastDigit), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		sum += twoDigitNumber
+	}
+	return sum, nil
+}
+
+func ReplaceLetters(s string) string {
+	switch s {
+	case "one":
+		return "1"
+	case "two":
+		return "2"
+	case "three":
+		return "3"
+	case "four":
+		return "4"
+	case "five":
+		return "5"
+	case "six":
+		return "6"
+	case "seven":
+		return "7"
+	case "eight":
+		return "8"
+	case "nine":
+		return "9"
+	case "zero":
+		return "0"
+	default:
+		return s
+	}
+}
+
+func Reverse(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
@@ -0,0 +1,11 @@
+package day01
+
+import (
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay01(t *testing.T) {
+	aoctest.Run(t, 1, aoctest.Parts(part1, part2))
+}
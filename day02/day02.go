@@ -0,0 +1,157 @@
+package day02
+
+import (
+	"fmt"
+
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(2, solver.NewParsed(parseGames, sumPossibleIds, sumPowers))
+}
+
+func part1(input string) (int, error) {
+	games, err := parseGames(input)
+	if err != nil {
+		return 0, err
+	}
+	return sumPossibleIds(games)
+}
+
+func part2(input string) (int, error) {
+	games, err := parseGames(input)
+	if err != nil {
+		return 0, err
+	}
+	return sumPowers(games)
+}
+
+func sumPossibleIds(games []Game) (int, error) {
+	configuration := CubeSet{
+		RedCount:   12,
+		GreenCount: 13,
+		BlueCount:  14,
+	}
+	sum := 0
+	for _, game := range games {
+		if game.IsPossibleWith(configuration) {
+			sum += game.id
+		}
+	}
+	return sum, nil
+}
+
+func sumPowers(games []Game) (int, error) {
+	sum := 0
+	for _, game := range games {
+		sum += game.powerOfTheMinimumSetOfCubes()
+	}
+	return sum, nil
+}
+
+func parseGames(input string) ([]Game, error) {
+	return mapSlice(inputs.Lines(input), parseGame)
+}
+
+func parseGame(line inputs.Span) (Game, error) {
+	line, err := line.TrimPrefix("Game ")
+	if err != nil {
+		return Game{}, err
+	}
+	rawId, rawCubeSets, err := line.Cut(":")
+	if err != nil {
+		return Game{}, err
+	}
+	gameId, err := rawId.Int()
+	if err != nil {
+		return Game{}, err
+	}
+	cubeSets, err := mapSlice(rawCubeSets.Split(";"), parseCubeSet)
+	if err != nil {
+		return Game{}, err
+	}
+	return Game{
+		id:       gameId,
+		cubeSets: cubeSets,
+	}, nil
+}
+
+func parseCubeSet(raw inputs.Span) (CubeSet, error) {
+	cubeSet := CubeSet{}
+	for _, rawCount := range raw.Split(",") {
+		pair := rawCount.Fields()
+		if len(pair) != 2 {
+			return CubeSet{}, rawCount.TrimSpace().Errorf("want count and color, got")
+		}
+		count, err := pair[0].Int()
+		if err != nil {
+			return CubeSet{}, err
+		}
+		switch pair[1].Text {
+		case "red":
+			cubeSet.RedCount = count
+		case "green":
+			cubeSet.GreenCount = count
+		case "blue":
+			cubeSet.BlueCount = count
+		default:
+			return CubeSet{}, pair[1].Errorf("unknown color")
+		}
+	}
+	return cubeSet, nil
+}
+
+func mapSlice[T any, M any](a []T, f func(T) (M, error)) ([]M, error) {
+	n := make([]M, len(a))
+	for i, e := range a {
+		var err error
+		n[i], err = f(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
+type Game struct {
+	id       int
+	cubeSets []CubeSet
+}
+
+type CubeSet struct {
+	RedCount   int
+	GreenCount int
+	BlueCount  int
+}
+
+func (g Game) String() string {
+	return fmt.Sprintf("%d", g)
+}
+
+func (g Game) IsPossibleWith(configuration CubeSet) bool {
+	for _, cubeSet := range g.cubeSets {
+		if cubeSet.RedCount > configuration.RedCount ||
+			cubeSet.GreenCount > configuration.GreenCount ||
+			cubeSet.BlueCount > configuration.BlueCount {
+			return false
+		}
+	}
+	return true
+}
+
+func (g Game) powerOfTheMinimumSetOfCubes() int {
+	minSetOfCubes := CubeSet{}
+	for _, cubeSet := range g.cubeSets {
+		if cubeSet.RedCount > minSetOfCubes.RedCount {
+			minSetOfCubes.RedCount = cubeSet.RedCount
+		}
+		if cubeSet.GreenCount > minSetOfCubes.GreenCount {
+			minSetOfCubes.GreenCount = cubeSet.GreenCount
+		}
+		if cubeSet.BlueCount > minSetOfCubes.BlueCount {
+			minSetOfCubes.BlueCount = cubeSet.BlueCount
+		}
+	}
+	return minSetOfCubes.RedCount * minSetOfCubes.GreenCount * minSetOfCubes.BlueCount
+}
@@ -0,0 +1,11 @@
+package day02
+
+import (
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay02(t *testing.T) {
+	aoctest.Run(t, 2, aoctest.Parts(part1, part2))
+}
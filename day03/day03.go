@@ -0,0 +1,103 @@
+package day03
+
+import (
+	"strings"
+	"unicode"
+
+	"aoc2023/grid"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(3, solver.NewParsed(grid.Parse, sumPartNumbers, sumGearRatios))
+}
+
+const symbols = "!\"§$%&/()=?*+~#-@/"
+
+func part1(input string) (int, error) {
+	schematic, err := grid.Parse(input)
+	if err != nil {
+		return 0, err
+	}
+	return sumPartNumbers(schematic)
+}
+
+func part2(input string) (int, error) {
+	schematic, err := grid.Parse(input)
+	if err != nil {
+		return 0, err
+	}
+	return sumGearRatios(schematic)
+}
+
+func sumPartNumbers(schematic grid.Grid[byte]) (int, error) {
+	sum := 0
+	for row := 0; row < schematic.Rows(); row++ {
+		num := 0
+		isPartNumber := false
+		for col, char := range schematic.Row(row) {
+			if unicode.IsDigit(rune(char)) {
+				num *= 10
+				num += int(char - '0')
+				for _, neighbor := range schematic.Neighbors8(grid.Point{Row: row, Col: col}) {
+					if strings.IndexByte(symbols, schematic.At(neighbor)) >= 0 {
+						isPartNumber = true
+					}
+				}
+			} else {
+				if isPartNumber {
+					sum += num
+				}
+				num = 0
+				isPartNumber = false
+			}
+		}
+		if isPartNumber {
+			sum += num
+		}
+	}
+	return sum, nil
+}
+
+func sumGearRatios(schematic grid.Grid[byte]) (int, error) {
+	sum := 0
+
+	gears := grid.New[int](schematic.Rows(), schematic.Cols())
+
+	addToGear := func(gear grid.Point, num int) {
+		if gears.At(gear) != 0 {
+			sum += (num * gears.At(gear))
+		} else {
+			gears.Set(gear, num)
+		}
+	}
+
+	for row := 0; row < schematic.Rows(); row++ {
+		num := 0
+		isGear := false
+		gear := grid.Point{}
+		for col, char := range schematic.Row(row) {
+			if unicode.IsDigit(rune(char)) {
+				num *= 10
+				num += int(char - '0')
+				for _, neighbor := range schematic.Neighbors8(grid.Point{Row: row, Col: col}) {
+					if schematic.At(neighbor) == '*' {
+						gear = neighbor
+						isGear = true
+						break
+					}
+				}
+			} else {
+				if isGear {
+					addToGear(gear, num)
+				}
+				num = 0
+				isGear = false
+			}
+		}
+		if isGear {
+			addToGear(gear, num)
+		}
+	}
+	return sum, nil
+}
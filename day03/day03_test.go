@@ -0,0 +1,11 @@
+package day03
+
+import (
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay03(t *testing.T) {
+	aoctest.Run(t, 3, aoctest.Parts(part1, part2))
+}
@@ -0,0 +1,126 @@
+package day04
+
+import (
+	"math"
+	"slices"
+
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(4, solver.NewParsed(parseCards, sumWorth, countCards))
+}
+
+func part1(input string) (int, error) {
+	cards, err := parseCards(input)
+	if err != nil {
+		return 0, err
+	}
+	return sumWorth(cards)
+}
+
+func part2(input string) (int, error) {
+	cards, err := parseCards(input)
+	if err != nil {
+		return 0, err
+	}
+	return countCards(cards)
+}
+
+func sumWorth(cards []Card) (int, error) {
+	sum := 0
+	for _, card := range cards {
+		sum += card.worth()
+	}
+	return sum, nil
+}
+
+// countCards returns the number of cards including all won copies.
+func countCards(cards []Card) (int, error) {
+	cardCounts := make([]int, len(cards))
+	for i := range cardCounts {
+		cardCounts[i] = 1
+	}
+	sum := 0
+	for i, card := range cards {
+		winCount := min(card.winCount(), len(cards)-1-i)
+		copiedCards := cardCounts[i+1 : i+1+winCount]
+		for j := range copiedCards {
+			copiedCards[j] += cardCounts[i]
+		}
+		sum += cardCounts[i]
+	}
+	return sum, nil
+}
+
+func parseCards(input string) ([]Card, error) {
+	lines := inputs.Lines(input)
+	cards := make([]Card, len(lines))
+	for i, line := range lines {
+		var err error
+		cards[i], err = parseCard(line)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return cards, nil
+}
+
+func parseCard(s inputs.Span) (Card, error) {
+	s, err := s.TrimPrefix("Card")
+	if err != nil {
+		return Card{}, err
+	}
+	rawId, allNumbers, err := s.Cut(":")
+	if err != nil {
+		return Card{}, err
+	}
+	cardId, err := rawId.TrimSpace().Int()
+	if err != nil {
+		return Card{}, err
+	}
+	rawWinningNumbers, rawNumbers, err := allNumbers.Cut("|")
+	if err != nil {
+		return Card{}, err
+	}
+	winningNumbers, err := rawWinningNumbers.Ints()
+	if err != nil {
+		return Card{}, err
+	}
+	numbers, err := rawNumbers.Ints()
+	if err != nil {
+		return Card{}, err
+	}
+
+	return Card{
+		id:             cardId,
+		winningNumbers: winningNumbers,
+		numbers:        numbers,
+	}, nil
+}
+
+type Card struct {
+	id             int
+	winningNumbers []int
+	numbers        []int
+}
+
+func (c Card) winCount() int {
+	winCount := 0
+	for _, num := range c.numbers {
+		if slices.Contains(c.winningNumbers, num) {
+			winCount++
+		}
+	}
+	return winCount
+}
+
+func (c Card) worth() int {
+	winCount := c.winCount()
+	if winCount > 0 {
+		return int(math.Pow(float64(2), float64(winCount-1)))
+	} else {
+		return 0
+	}
+}
@@ -0,0 +1,11 @@
+package day04
+
+import (
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay04(t *testing.T) {
+	aoctest.Run(t, 4, aoctest.Parts(part1, part2))
+}
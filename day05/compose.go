@@ -0,0 +1,127 @@
+package day05
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"aoc2023/interval"
+)
+
+// Seeds is the domain of composed functions: all numbers the almanac may
+// be asked about.
+var Seeds = interval.Interval{Start: 0, End: 1 << 62}
+
+// Piece maps every seed in Seeds to seed+Offset.
+type Piece struct {
+	Seeds  interval.Interval
+	Offset int
+}
+
+// Locations returns the interval the piece maps its seeds to.
+func (p Piece) Locations() interval.Interval {
+	return p.Seeds.Shift(p.Offset)
+}
+
+// Function is a piecewise linear mapping of seeds to locations. Its pieces
+// are sorted, do not overlap and cover the Seeds domain.
+type Function []Piece
+
+// Compose combines all maps of the almanac into a single function that
+// maps a seed directly to its location.
+func (a Almanac) Compose() Function {
+	f := Function{{Seeds, 0}}
+	for _, m := range a.maps {
+		f = f.then(m)
+	}
+	return f
+}
+
+// then returns the function that applies m to the results of f.
+func (f Function) then(m Map) Function {
+	result := Function{}
+	for _, piece := range f {
+		// like Map.Map, the first range containing a number maps it
+		images := []interval.Interval{piece.Locations()}
+		for _, r := range m.ranges {
+			for _, image := range images {
+				if mapped := image.Intersect(r.Source()); !mapped.Empty() {
+					result = append(result, Piece{mapped.Shift(-piece.Offset), piece.Offset + r.Offset()})
+				}
+			}
+			images = interval.SubtractAll(images, []interval.Interval{r.Source()})
+		}
+		for _, unmapped := range images {
+			result = append(result, Piece{unmapped.Shift(-piece.Offset), piece.Offset})
+		}
+	}
+	slices.SortFunc(result, func(a, b Piece) int { return a.Seeds.Start - b.Seeds.Start })
+
+	// join neighbors that shift by the same offset
+	joined := Function{}
+	for _, piece := range result {
+		if last := len(joined) - 1; last >= 0 && joined[last].Offset == piece.Offset && joined[last].Seeds.End == piece.Seeds.Start {
+			joined[last].Seeds.End = piece.Seeds.End
+			continue
+		}
+		joined = append(joined, piece)
+	}
+	return joined
+}
+
+// Apply returns the location of a seed.
+func (f Function) Apply(seed int) int {
+	i, _ := slices.BinarySearchFunc(f, seed, func(p Piece, seed int) int {
+		switch {
+		case p.Seeds.End <= seed:
+			return -1
+		case p.Seeds.Start > seed:
+			return 1
+		}
+		return 0
+	})
+	if i == len(f) || !f[i].Seeds.Contains(seed) {
+		return seed
+	}
+	return seed + f[i].Offset
+}
+
+// Breakpoints returns the seeds at which a new piece starts.
+func (f Function) Breakpoints() []int {
+	breakpoints := make([]int, len(f))
+	for i, piece := range f {
+		breakpoints[i] = piece.Seeds.Start
+	}
+	return breakpoints
+}
+
+// Seeds returns all seeds, in ascending order, that land on the location;
+// the maps need not be one-to-one.
+func (f Function) Seeds(location int) []int {
+	seeds := []int{}
+	for _, piece := range f {
+		if seed := location - piece.Offset; piece.Seeds.Contains(seed) {
+			seeds = append(seeds, seed)
+		}
+	}
+	slices.Sort(seeds)
+	return seeds
+}
+
+// String lists the pieces, one per line, like "[50,98) +2 -> [52,100)".
+func (f Function) String() string {
+	builder := strings.Builder{}
+	for _, piece := range f {
+		fmt.Fprintf(&builder, "%v %+d -> %v\n", piece.Seeds, piece.Offset, piece.Locations())
+	}
+	return builder.String()
+}
+
+// Names returns the names of the maps in the order they are applied.
+func (a Almanac) Names() []string {
+	names := make([]string, len(a.maps))
+	for i, m := range a.maps {
+		names[i] = m.name
+	}
+	return names
+}
@@ -0,0 +1,220 @@
+package day05
+
+import (
+	"errors"
+	"fmt"
+
+	"aoc2023/inputs"
+	"aoc2023/interval"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(5, solver.NewParsed(parsePuzzle, lowestLocation, lowestRangeLocation))
+}
+
+func part1(input string) (int, error) {
+	p, err := parsePuzzle(input)
+	if err != nil {
+		return 0, err
+	}
+	return lowestLocation(p)
+}
+
+func part2(input string) (int, error) {
+	p, err := parsePuzzle(input)
+	if err != nil {
+		return 0, err
+	}
+	return lowestRangeLocation(p)
+}
+
+// puzzle is the almanac together with the seed numbers.
+type puzzle struct {
+	almanac Almanac
+	seeds   []int
+}
+
+func parsePuzzle(input string) (puzzle, error) {
+	almanac, seeds, err := ParseAlmanac(input)
+	return puzzle{almanac, seeds}, err
+}
+
+func lowestLocation(p puzzle) (int, error) {
+	almanac, seeds := p.almanac, p.seeds
+	minLocation := almanac.Location(seeds[0])
+	for _, seed := range seeds[1:] {
+		location := almanac.Location(seed)
+		if location < minLocation {
+			minLocation = location
+		}
+	}
+
+	return minLocation, nil
+}
+
+func lowestRangeLocation(p puzzle) (int, error) {
+	almanac, seeds := p.almanac, p.seeds
+	if len(seeds)%2 != 0 {
+		return 0, errors.New("seed ranges: odd number of values")
+	}
+
+	seedRanges := []interval.Interval{}
+	for i := 0; i < len(seeds); i += 2 {
+		seedRanges = append(seedRanges, interval.Of(seeds[i], seeds[i+1]))
+	}
+	locations := almanac.Locations(seedRanges)
+	if len(locations) == 0 {
+		return 0, errors.New("seed ranges are empty")
+	}
+	// merged intervals are sorted
+	return locations[0].Start, nil
+}
+
+type Almanac struct {
+	maps []Map
+}
+
+func (a Almanac) Location(seed int) int {
+	temp := seed
+	for _, _map := range a.maps {
+		temp = _map.Map(temp)
+	}
+	return temp
+}
+
+// Locations maps intervals of seeds through all maps and returns the
+// merged intervals of their locations.
+func (a Almanac) Locations(seeds []interval.Interval) []interval.Interval {
+	temp := interval.Merge(seeds)
+	for i, _map := range a.maps {
+		temp = _map.MapIntervals(temp)
+		fmt.Fprintf(solver.Debug, "after map %d: %d intervals\n", i+1, len(temp))
+	}
+	return temp
+}
+
+// ParseAlmanac returns the maps and the seed numbers of the input.
+func ParseAlmanac(input string) (Almanac, []int, error) {
+	lines := inputs.Lines(input)
+	blocks := inputs.Blocks(input)
+	first := 0 // index of the first line of the block
+	for i, block := range blocks {
+		if len(block) == 0 {
+			// point at the empty line where the block should start
+			at := lines[min(first, len(lines)-1)]
+			if i == 0 {
+				return Almanac{}, nil, at.MissingErrorf("missing seeds")
+			}
+			return Almanac{}, nil, at.MissingErrorf("missing map")
+		}
+		first += len(block) + 1
+	}
+
+	rawSeeds, err := blocks[0][0].TrimPrefix("seeds:")
+	if err != nil {
+		return Almanac{}, nil, err
+	}
+	seeds, err := rawSeeds.Ints()
+	if err != nil {
+		return Almanac{}, nil, err
+	}
+	if len(seeds) == 0 {
+		return Almanac{}, nil, rawSeeds.MissingErrorf("no seeds")
+	}
+	if len(blocks[0]) > 1 {
+		return Almanac{}, nil, blocks[0][1].Errorf("want empty line, got")
+	}
+
+	maps := make([]Map, len(blocks)-1)
+	for i := range maps {
+		maps[i], err = parseMap(blocks[i+1])
+		if err != nil {
+			return Almanac{}, nil, err
+		}
+	}
+	return Almanac{maps: maps}, seeds, nil
+}
+
+func parseMap(lines []inputs.Span) (Map, error) {
+	name, err := lines[0].TrimSuffix(" map:")
+	if err != nil {
+		return Map{}, err
+	}
+	ranges := make([]Range, len(lines)-1)
+	for i, line := range lines[1:] {
+		numbers, err := line.Ints()
+		if err != nil {
+			return Map{}, err
+		}
+		if len(numbers) != 3 {
+			return Map{}, line.Errorf("want destination, source and length, got")
+		}
+		ranges[i] = Range{
+			sourceRangeStart:      numbers[1],
+			destinationRangeStart: numbers[0],
+			length:                numbers[2],
+		}
+	}
+	return Map{
+		name:   name.Text,
+		ranges: ranges,
+	}, nil
+}
+
+type Map struct {
+	name   string // like "seed-to-soil"
+	ranges []Range
+}
+
+func (m Map) Map(source int) int {
+	for _, r := range m.ranges {
+		if r.CanMap(source) {
+			return r.Map(source)
+		}
+	}
+	return source
+}
+
+// MapIntervals maps every number in the intervals and returns the merged
+// intervals of the results. Each interval is split at the borders of the
+// ranges: like Map, the first range containing a part shifts it, the parts
+// outside of all ranges map to themselves.
+func (m Map) MapIntervals(sources []interval.Interval) []interval.Interval {
+	result := []interval.Interval{}
+	unmapped := sources
+	for _, r := range m.ranges {
+		for _, source := range unmapped {
+			if mapped := source.Intersect(r.Source()); !mapped.Empty() {
+				result = append(result, mapped.Shift(r.Offset()))
+			}
+		}
+		unmapped = interval.SubtractAll(unmapped, []interval.Interval{r.Source()})
+	}
+	result = append(result, unmapped...)
+	return interval.Merge(result)
+}
+
+type Range struct {
+	sourceRangeStart      int
+	destinationRangeStart int
+	length                int
+}
+
+func (r Range) CanMap(source int) bool {
+	return r.sourceRangeStart <= source && source < r.sourceRangeStart+r.length
+}
+
+func (r Range) Map(source int) int {
+	return source + r.Offset()
+}
+
+// Source returns the interval of numbers the range maps.
+func (r Range) Source() interval.Interval {
+	return interval.Of(r.sourceRangeStart, r.length)
+}
+
+// Offset returns the difference between a mapped number and its source.
+func (r Range) Offset() int {
+	return r.destinationRangeStart - r.sourceRangeStart
+}
@@ -0,0 +1,107 @@
+package day05
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"aoc2023/aoctest"
+	"aoc2023/interval"
+)
+
+func TestDay05(t *testing.T) {
+	aoctest.Run(t, 5, aoctest.Parts(part1, part2))
+}
+
+// TestLocations compares mapping intervals with mapping every seed on its own.
+func TestLocations(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	for round := 0; round < 50; round++ {
+		almanac := Almanac{}
+		for m := 0; m < 4; m++ {
+			ranges := []Range{}
+			// the puzzle's source ranges never overlap, but every other
+			// round lets them, where the first matching range wins
+			start := rng.Intn(5)
+			for r := rng.Intn(4); r > 0; r-- {
+				length := 1 + rng.Intn(10)
+				ranges = append(ranges, Range{start, rng.Intn(60), length})
+				if round%2 == 0 {
+					start += length + rng.Intn(5)
+				} else {
+					start = rng.Intn(30)
+				}
+			}
+			almanac.maps = append(almanac.maps, Map{fmt.Sprint("map", m), ranges})
+		}
+
+		seeds := []interval.Interval{interval.Of(rng.Intn(40), rng.Intn(20)), interval.Of(rng.Intn(40), rng.Intn(20))}
+		want := map[int]bool{}
+		for _, seedRange := range seeds {
+			for seed := seedRange.Start; seed < seedRange.End; seed++ {
+				want[almanac.Location(seed)] = true
+			}
+		}
+
+		got := map[int]bool{}
+		for _, locations := range almanac.Locations(seeds) {
+			for location := locations.Start; location < locations.End; location++ {
+				got[location] = true
+			}
+		}
+		if len(got) != len(want) {
+			t.Fatalf("round %d: got %d locations, want %d", round, len(got), len(want))
+		}
+		for location := range want {
+			if !got[location] {
+				t.Fatalf("round %d: location %d missing", round, location)
+			}
+		}
+
+		f := almanac.Compose()
+		preimages := map[int][]int{}
+		for seed := 0; seed < 100; seed++ {
+			location := almanac.Location(seed)
+			if got := f.Apply(seed); got != location {
+				t.Fatalf("round %d: Apply(%d) = %d, want %d", round, seed, got, location)
+			}
+			preimages[location] = append(preimages[location], seed)
+		}
+		for location, seeds := range preimages {
+			// numbers from 100 on lie beyond all ranges and map to themselves
+			if got := f.Seeds(location); location < 100 && !slices.Equal(got, seeds) {
+				t.Fatalf("round %d: Seeds(%d) = %v, want %v", round, location, got, seeds)
+			}
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	input, err := os.ReadFile("testdata/example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	almanac, _, err := ParseAlmanac(strings.TrimRight(string(input), "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := almanac.Compose()
+	for seed, want := range map[int]int{79: 82, 14: 43, 55: 86, 13: 35} {
+		if got := f.Apply(seed); got != want {
+			t.Errorf("Apply(%d) = %d, want %d", seed, got, want)
+		}
+		if got := f.Seeds(want); !slices.Contains(got, seed) {
+			t.Errorf("Seeds(%d) = %v, want to contain %d", want, got, seed)
+		}
+	}
+	if got := f.Seeds(-1); len(got) != 0 {
+		t.Errorf("Seeds(-1) = %v, want none", got)
+	}
+	breakpoints := f.Breakpoints()
+	if len(breakpoints) != len(f) || !slices.IsSorted(breakpoints) || !slices.Equal(breakpoints[:4], []int{0, 14, 15, 22}) {
+		t.Errorf("Breakpoints() = %v, want one per piece from 0, 14, 15, 22", breakpoints)
+	}
+}
@@ -0,0 +1,184 @@
+package day06
+
+import (
+	"math/big"
+	"strings"
+
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(6, solver.NewParsed(parseSheet, Sheet.productOfWays, Sheet.waysForJoinedRace))
+}
+
+func part1(input string) (*big.Int, error) {
+	sheet, err := parseSheet(input)
+	if err != nil {
+		return nil, err
+	}
+	return sheet.productOfWays()
+}
+
+func part2(input string) (*big.Int, error) {
+	sheet, err := parseSheet(input)
+	if err != nil {
+		return nil, err
+	}
+	return sheet.waysForJoinedRace()
+}
+
+// Sheet holds both readings of the input: the races of every column and
+// the single race with the digits of all columns joined.
+type Sheet struct {
+	races  []Race
+	joined Race
+}
+
+func parseSheet(input string) (Sheet, error) {
+	races, err := parseRaces(input)
+	if err != nil {
+		return Sheet{}, err
+	}
+	joined, err := parseRace(input)
+	if err != nil {
+		return Sheet{}, err
+	}
+	return Sheet{races, joined}, nil
+}
+
+func (s Sheet) productOfWays() (*big.Int, error) {
+	result := big.NewInt(1)
+	for _, race := range s.races {
+		result.Mul(result, race.countWaysToWin())
+	}
+	return result, nil
+}
+
+func (s Sheet) waysForJoinedRace() (*big.Int, error) {
+	return s.joined.countWaysToWin(), nil
+}
+
+func parseRaces(input string) ([]Race, error) {
+	times, distances, err := parseLines(input)
+	if err != nil {
+		return nil, err
+	}
+	timeFields, distanceFields := times.Fields(), distances.Fields()
+	if len(timeFields) != len(distanceFields) {
+		return nil, distances.Errorf("want %d distances, got", len(timeFields))
+	}
+	races := make([]Race, len(timeFields))
+	for i := range races {
+		races[i].timeAllowed, err = parseNumber(timeFields[i])
+		if err != nil {
+			return nil, err
+		}
+		races[i].recordDistance, err = parseNumber(distanceFields[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return races, nil
+}
+
+// parseRace reads the input as a single race, ignoring the spaces between digits.
+func parseRace(input string) (Race, error) {
+	times, distances, err := parseLines(input)
+	if err != nil {
+		return Race{}, err
+	}
+	time, err := joinDigits(times)
+	if err != nil {
+		return Race{}, err
+	}
+	distance, err := joinDigits(distances)
+	if err != nil {
+		return Race{}, err
+	}
+	return Race{
+		timeAllowed:    time,
+		recordDistance: distance,
+	}, nil
+}
+
+// parseLines returns the values of the time and distance lines.
+func parseLines(input string) (times, distances inputs.Span, err error) {
+	lines := inputs.Lines(input)
+	if len(lines) != 2 {
+		return times, distances, lines[len(lines)-1].MissingErrorf("want 2 lines, got %d", len(lines))
+	}
+	times, err = lines[0].TrimPrefix("Time:")
+	if err != nil {
+		return times, distances, err
+	}
+	distances, err = lines[1].TrimPrefix("Distance:")
+	return times, distances, err
+}
+
+func joinDigits(s inputs.Span) (*big.Int, error) {
+	for _, field := range s.Fields() {
+		if _, err := parseNumber(field); err != nil {
+			return nil, err
+		}
+	}
+	value, ok := new(big.Int).SetString(strings.Join(strings.Fields(s.Text), ""), 10)
+	if !ok {
+		return nil, s.TrimSpace().MissingErrorf("missing number")
+	}
+	return value, nil
+}
+
+// parseNumber parses a time or distance, which must not be negative.
+func parseNumber(s inputs.Span) (*big.Int, error) {
+	n, err := s.BigInt()
+	if err != nil {
+		return nil, err
+	}
+	if n.Sign() < 0 {
+		return nil, s.Errorf("want non-negative number, got")
+	}
+	return n, nil
+}
+
+type Race struct {
+	timeAllowed    *big.Int
+	recordDistance *big.Int
+}
+
+// countWaysToWin returns the number of button times h with (T-h)*h > D,
+// where T is the time allowed and D the record distance. The winning times
+// lie strictly between the roots (T ± sqrt(T²-4D))/2 of h²-T*h+D, and as h
+// wins exactly if T-h does, they are symmetric around T/2: all times from
+// the smallest winning h up to T-h win.
+func (r Race) countWaysToWin() *big.Int {
+	t, d := r.timeAllowed, r.recordDistance
+	discriminant := new(big.Int).Mul(t, t)
+	discriminant.Sub(discriminant, new(big.Int).Lsh(d, 2))
+	if discriminant.Sign() <= 0 {
+		return new(big.Int)
+	}
+
+	// the floor of the smaller root, rounded down once more by the integer
+	// square root, lies at most one below the smallest winning time
+	h := new(big.Int).Sqrt(discriminant)
+	h.Sub(t, h).Rsh(h, 1)
+	if h.Sign() < 0 {
+		h.SetInt64(0)
+	}
+	for !r.wins(h) {
+		h.Add(h, big.NewInt(1))
+		if new(big.Int).Lsh(h, 1).Cmp(t) > 0 {
+			return new(big.Int)
+		}
+	}
+	count := new(big.Int).Sub(t, new(big.Int).Lsh(h, 1))
+	return count.Add(count, big.NewInt(1))
+}
+
+// wins reports whether holding the button for h milliseconds beats the record.
+func (r Race) wins(h *big.Int) bool {
+	distance := new(big.Int).Sub(r.timeAllowed, h)
+	distance.Mul(distance, h)
+	return distance.Cmp(r.recordDistance) > 0
+}
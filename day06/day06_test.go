@@ -0,0 +1,55 @@
+package day06
+
+import (
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay06(t *testing.T) {
+	aoctest.Run(t, 6, aoctest.Parts(part1, part2))
+}
+
+// countWaysToWinBruteForce tries every button time.
+func countWaysToWinBruteForce(timeAllowed, recordDistance int) int {
+	winCount := 0
+	for i := 0; i < timeAllowed; i++ {
+		distance := (timeAllowed - i) * i
+		if distance > recordDistance {
+			winCount++
+		}
+	}
+	return winCount
+}
+
+func TestCountWaysToWin(t *testing.T) {
+	rng := rand.New(rand.NewSource(6))
+	for round := 0; round < 2000; round++ {
+		timeAllowed := rng.Intn(200)
+		// records near the best possible distance are the interesting ones
+		recordDistance := rng.Intn(timeAllowed*timeAllowed/4 + 2)
+		race := Race{big.NewInt(int64(timeAllowed)), big.NewInt(int64(recordDistance))}
+		want := countWaysToWinBruteForce(timeAllowed, recordDistance)
+		if got := race.countWaysToWin(); got.Cmp(big.NewInt(int64(want))) != 0 {
+			t.Fatalf("time %d, distance %d: got %v, want %d", timeAllowed, recordDistance, got, want)
+		}
+	}
+}
+
+func TestCountWaysToWinHugeRace(t *testing.T) {
+	// with time 10^k+1 and record 10^k, only the times 2..10^k-1 win
+	digits := "1" + strings.Repeat("0", 300)
+	input := "Time:      " + digits[:len(digits)-1] + "1\nDistance:  " + digits
+	got, err := part2(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := new(big.Int).SetString(digits, 10)
+	want.Sub(want, big.NewInt(2))
+	if got.Cmp(want) != 0 {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
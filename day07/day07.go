@@ -0,0 +1,267 @@
+package day07
+
+import (
+	"slices"
+	"strings"
+
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(7, solver.NewParsed(parseBids, Camel.totalWinnings, CamelJokers.totalWinnings))
+}
+
+func part1(input string) (int, error) {
+	bids, err := parseBids(input)
+	if err != nil {
+		return 0, err
+	}
+	return Camel.totalWinnings(bids)
+}
+
+func part2(input string) (int, error) {
+	bids, err := parseBids(input)
+	if err != nil {
+		return 0, err
+	}
+	return CamelJokers.totalWinnings(bids)
+}
+
+func (r Rules) totalWinnings(bids []Bid) (int, error) {
+	hands := make([]Hand, len(bids))
+	for i, bid := range bids {
+		var err error
+		hands[i], err = r.hand(bid)
+		if err != nil {
+			return 0, err
+		}
+	}
+	slices.SortFunc(hands, r.Compare)
+
+	totalWinnings := 0
+	for i, hand := range hands {
+		rank := i + 1
+		totalWinnings += (rank * hand.bidAmount)
+	}
+	return totalWinnings, nil
+}
+
+// Types of Camel Cards, which only count equal cards.
+var camelTypes = []HandType{
+	{Name: "high card", Groups: []int{1}},
+	{Name: "one pair", Groups: []int{2}},
+	{Name: "two pair", Groups: []int{2, 2}},
+	{Name: "three of a kind", Groups: []int{3}},
+	{Name: "full house", Groups: []int{3, 2}},
+	{Name: "four of a kind", Groups: []int{4}},
+	{Name: "five of a kind", Groups: []int{5}},
+}
+
+// Camel are the rules of part 1.
+var Camel = Rules{
+	Order:    "23456789TJQKA",
+	HandSize: 5,
+	Types:    camelTypes,
+}
+
+// CamelJokers are the rules of part 2: J is a joker and the weakest card.
+var CamelJokers = Rules{
+	Order:     "J23456789TQKA",
+	Wildcards: "J",
+	HandSize:  5,
+	Types:     camelTypes,
+}
+
+// Poker ranks hands like poker without suits, so there are no flushes, and
+// aces only count high in straights.
+var Poker = Rules{
+	Order:    "23456789TJQKA",
+	HandSize: 5,
+	Types: []HandType{
+		{Name: "high card", Groups: []int{1}},
+		{Name: "one pair", Groups: []int{2}},
+		{Name: "two pair", Groups: []int{2, 2}},
+		{Name: "three of a kind", Groups: []int{3}},
+		{Name: "straight", Straight: true},
+		{Name: "full house", Groups: []int{3, 2}},
+		{Name: "four of a kind", Groups: []int{4}},
+		{Name: "five of a kind", Groups: []int{5}},
+	},
+}
+
+// Rules rank hands of cards.
+type Rules struct {
+	// Order lists the card labels from weakest to strongest, including the
+	// wildcards. It breaks ties between hands of the same type.
+	Order string
+	// Wildcards lists the labels that act like whatever card makes the
+	// hand strongest.
+	Wildcards string
+	// HandSize is the number of cards in a hand.
+	HandSize int
+	// Types lists the hand types from weakest to strongest. A hand is of
+	// the strongest type it matches.
+	Types []HandType
+}
+
+// HandType is a kind of hand like "full house".
+type HandType struct {
+	Name string
+	// Groups are the sizes of groups of equal cards the hand contains at
+	// least, like {3, 2} for a full house.
+	Groups []int
+	// Straight requires the cards to be consecutive in Order, not
+	// counting the wildcards.
+	Straight bool
+}
+
+type Hand struct {
+	cards     string
+	bidAmount int
+	handType  int
+}
+
+// Compare orders hands by type, then by their cards from first to last.
+func (r Rules) Compare(a, b Hand) int {
+	if a.handType != b.handType {
+		return a.handType - b.handType
+	}
+	for k := range a.cards {
+		if compare := strings.IndexByte(r.Order, a.cards[k]) - strings.IndexByte(r.Order, b.cards[k]); compare != 0 {
+			return compare
+		}
+	}
+	return 0
+}
+
+// Classify returns the index in Types of the strongest type the cards
+// match, or -1 if they match none.
+func (r Rules) Classify(cards string) int {
+	wildcards := 0
+	count := map[byte]int{}
+	for i := 0; i < len(cards); i++ {
+		if strings.IndexByte(r.Wildcards, cards[i]) >= 0 {
+			wildcards++
+		} else {
+			count[cards[i]]++
+		}
+	}
+	counts := make([]int, 0, len(count))
+	for _, c := range count {
+		counts = append(counts, c)
+	}
+	slices.Sort(counts)
+	slices.Reverse(counts)
+
+	for t := len(r.Types) - 1; t >= 0; t-- {
+		handType := r.Types[t]
+		if !hasGroups(counts, wildcards, handType.Groups) {
+			continue
+		}
+		if handType.Straight && !r.isStraight(cards) {
+			continue
+		}
+		return t
+	}
+	return -1
+}
+
+// hasGroups reports whether wildcards can complete the counts of equal
+// cards, sorted in descending order, to the groups. Filling the largest
+// groups from the largest counts needs the fewest wildcards.
+func hasGroups(counts []int, wildcards int, groups []int) bool {
+	groups = slices.Clone(groups)
+	slices.Sort(groups)
+	slices.Reverse(groups)
+	missing := 0
+	for i, group := range groups {
+		if i < len(counts) {
+			group -= counts[i]
+		}
+		missing += max(group, 0)
+	}
+	return missing <= wildcards
+}
+
+// isStraight reports whether the cards are all different and, together
+// with the wildcards filling the gaps, form a run in Order.
+func (r Rules) isStraight(cards string) bool {
+	ranks := ""
+	for i := 0; i < len(r.Order); i++ {
+		if strings.IndexByte(r.Wildcards, r.Order[i]) < 0 {
+			ranks += r.Order[i : i+1]
+		}
+	}
+	if len(cards) > len(ranks) {
+		return false
+	}
+	seen := map[byte]bool{}
+	lowest, highest := len(ranks), -1
+	for i := 0; i < len(cards); i++ {
+		rank := strings.IndexByte(ranks, cards[i])
+		if rank < 0 {
+			continue
+		}
+		if seen[cards[i]] {
+			return false
+		}
+		seen[cards[i]] = true
+		lowest, highest = min(lowest, rank), max(highest, rank)
+	}
+	return highest-lowest < len(cards)
+}
+
+// Bid is a line of the input: the card labels and the bid amount.
+type Bid struct {
+	cards  inputs.Span
+	amount int
+}
+
+func parseBids(input string) ([]Bid, error) {
+	lines := inputs.Lines(input)
+	bids := make([]Bid, len(lines))
+	for i, line := range lines {
+		var err error
+		bids[i], err = parseBid(line)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bids, nil
+}
+
+// parseBid reads a line of card labels and the bid.
+func parseBid(s inputs.Span) (Bid, error) {
+	fields := s.Fields()
+	if len(fields) != 2 {
+		return Bid{}, s.Errorf("want cards and bid, got")
+	}
+	amount, err := fields[1].Int()
+	if err != nil {
+		return Bid{}, err
+	}
+	return Bid{fields[0], amount}, nil
+}
+
+// hand checks the cards of a bid against the rules and classifies them.
+func (r Rules) hand(b Bid) (Hand, error) {
+	cards := b.cards
+	if len(cards.Text) != r.HandSize {
+		return Hand{}, cards.Errorf("want %d cards, got", r.HandSize)
+	}
+	for i := range cards.Text {
+		if strings.IndexByte(r.Order, cards.Text[i]) < 0 {
+			return Hand{}, cards.Slice(i, i+1).Errorf("unknown card")
+		}
+	}
+	handType := r.Classify(cards.Text)
+	if handType < 0 {
+		return Hand{}, cards.Errorf("no hand type matches")
+	}
+	return Hand{
+		cards:     cards.Text,
+		bidAmount: b.amount,
+		handType:  handType,
+	}, nil
+}
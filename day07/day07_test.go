@@ -0,0 +1,80 @@
+package day07
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay07(t *testing.T) {
+	aoctest.Run(t, 7, aoctest.Parts(part1, part2))
+}
+
+// TestWildcards compares classifying hands with wildcards to trying every
+// card in place of each wildcard.
+func TestWildcards(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	configs := []Rules{
+		CamelJokers,
+		{Order: "WJ23456789TQKA", Wildcards: "WJ", HandSize: 5, Types: camelTypes},
+		{Order: "J23456789TQKA", Wildcards: "J", HandSize: 6, Types: camelTypes},
+		{Order: "J23456789TQKA", Wildcards: "J", HandSize: 5, Types: Poker.Types},
+	}
+	for _, rules := range configs {
+		natural := rules
+		natural.Wildcards = ""
+		for round := 0; round < 500; round++ {
+			cards := make([]byte, rules.HandSize)
+			for i := range cards {
+				cards[i] = rules.Order[rng.Intn(len(rules.Order))]
+			}
+			want := bestSubstitute(natural, rules.Wildcards, string(cards))
+			if got := rules.Classify(string(cards)); got != want {
+				t.Fatalf("%s with wildcards %q: got %s, want %s",
+					cards, rules.Wildcards, rules.Types[got].Name, rules.Types[want].Name)
+			}
+		}
+	}
+}
+
+// bestSubstitute replaces the first wildcard by every other card and
+// returns the strongest type found.
+func bestSubstitute(rules Rules, wildcards string, cards string) int {
+	i := strings.IndexAny(cards, wildcards)
+	if i < 0 {
+		return rules.Classify(cards)
+	}
+	best := -1
+	for _, label := range []byte(rules.Order) {
+		if strings.IndexByte(wildcards, label) < 0 {
+			best = max(best, bestSubstitute(rules, wildcards, cards[:i]+string(label)+cards[i+1:]))
+		}
+	}
+	return best
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		rules Rules
+		cards string
+		want  string
+	}{
+		{Camel, "32T3K", "one pair"},
+		{Camel, "KTJJT", "two pair"},
+		{Camel, "T55J5", "three of a kind"},
+		{CamelJokers, "T55J5", "four of a kind"},
+		{CamelJokers, "JJJJJ", "five of a kind"},
+		{Camel, "23456", "high card"},
+		{Poker, "23456", "straight"},
+		{Poker, "T9QKJ", "straight"},
+		{Poker, "A2345", "high card"},
+		{Poker, "33322", "full house"},
+	}
+	for _, tt := range tests {
+		if got := tt.rules.Classify(tt.cards); tt.rules.Types[got].Name != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.cards, tt.rules.Types[got].Name, tt.want)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package day08
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"aoc2023/numth"
+)
+
+// ErrNeverTogether is returned when the ghosts are never all on target
+// nodes at the same step.
+var ErrNeverTogether = errors.New("ghosts never reach target nodes together")
+
+// Cycle describes the walk from a start node. Since the next step only
+// depends on the node and the position in the instructions, the walk
+// eventually repeats: after Start steps it loops every Length steps.
+type Cycle struct {
+	Start, Length int
+	// Transient are the steps before Start at which the walk is on a
+	// target node.
+	Transient []int
+	// Hits are the steps in [Start, Start+Length) at which the walk is on
+	// a target node; they recur every Length steps.
+	Hits []int
+}
+
+// cycle walks from the start node until it repeats a state and records
+// the steps at which it is on a node accepted by target.
+func (m Map) cycle(start *Node, target func(label string) bool) Cycle {
+	type state struct {
+		node        *Node
+		instruction int
+	}
+	firstSeen := map[state]int{}
+	steps := []int{}
+	current := state{start, 0}
+	for step := 0; ; step++ {
+		if first, ok := firstSeen[current]; ok {
+			i, _ := slices.BinarySearch(steps, first)
+			return Cycle{
+				Start:     first,
+				Length:    step - first,
+				Transient: steps[:i:i],
+				Hits:      steps[i:],
+			}
+		}
+		firstSeen[current] = step
+		if target(current.node.label) {
+			steps = append(steps, step)
+		}
+		if m.instructions[current.instruction] == 'L' {
+			current.node = current.node.leftChild
+		} else {
+			current.node = current.node.rightChild
+		}
+		current.instruction = (current.instruction + 1) % len(m.instructions)
+	}
+}
+
+// At reports whether the walk is on a target node after the given steps.
+func (c Cycle) At(step int) bool {
+	if step < c.Start {
+		_, found := slices.BinarySearch(c.Transient, step)
+		return found
+	}
+	_, found := slices.BinarySearch(c.Hits, c.Start+(step-c.Start)%c.Length)
+	return found
+}
+
+// First returns the first step at which the walk is on a target node.
+func (c Cycle) First() (int, bool) {
+	if len(c.Transient) > 0 {
+		return c.Transient[0], true
+	}
+	if len(c.Hits) > 0 {
+		return c.Hits[0], true
+	}
+	return 0, false
+}
+
+// together returns the first step at which all walks are on target nodes.
+// Steps before the last walk enters its loop must be transient hits of
+// some walk; from then on, every choice of one hit per loop gives a system
+// of congruences solved by the Chinese remainder theorem.
+func together(cycles []Cycle) (int, error) {
+	loopStart := 0
+	for _, c := range cycles {
+		loopStart = max(loopStart, c.Start)
+	}
+
+	if step, ok := earliestTransient(cycles, loopStart); ok {
+		return step, nil
+	}
+
+	best, found := 0, false
+	congruences := make([]numth.Congruence, len(cycles))
+	var combine func(i int) error
+	combine = func(i int) error {
+		if i == len(cycles) {
+			solution, err := numth.CRT(congruences...)
+			if errors.Is(err, numth.ErrNoSolution) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			// the first step from loopStart on that solves the congruences
+			step := solution.Residue
+			if step < loopStart {
+				step += (loopStart - step + solution.Modulus - 1) / solution.Modulus * solution.Modulus
+			}
+			if !found || step < best {
+				best, found = step, true
+			}
+			return nil
+		}
+		for _, hit := range cycles[i].Hits {
+			congruences[i] = numth.Congruence{Residue: hit % cycles[i].Length, Modulus: cycles[i].Length}
+			if err := combine(i + 1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := combine(0); err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, ErrNeverTogether
+	}
+	return best, nil
+}
+
+// earliestTransient returns the first step before loopStart at which all
+// walks are on target nodes.
+func earliestTransient(cycles []Cycle, loopStart int) (int, bool) {
+	best, found := loopStart, false
+	for _, c := range cycles {
+		for _, step := range c.Transient {
+			if step < best && allAt(cycles, step) {
+				best, found = step, true
+			}
+		}
+	}
+	return best, found
+}
+
+func allAt(cycles []Cycle, step int) bool {
+	for _, c := range cycles {
+		if !c.At(step) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c Cycle) String() string {
+	return fmt.Sprintf("transient hits %v, loop of %d steps from step %d with hits %v", c.Transient, c.Length, c.Start, c.Hits)
+}
@@ -0,0 +1,133 @@
+package day08
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(8, solver.NewParsed(parseMap, Map.stepsToZZZ, Map.ghostSteps))
+}
+
+func part1(input string) (int, error) {
+	desertMap, err := parseMap(input)
+	if err != nil {
+		return 0, err
+	}
+	return desertMap.stepsToZZZ()
+}
+
+func part2(input string) (int, error) {
+	desertMap, err := parseMap(input)
+	if err != nil {
+		return 0, err
+	}
+	return desertMap.ghostSteps()
+}
+
+// stepsToZZZ returns the number of steps from AAA to ZZZ.
+func (desertMap Map) stepsToZZZ() (int, error) {
+	start, ok := desertMap.nodes["AAA"]
+	if !ok {
+		return 0, errors.New("no node AAA")
+	}
+	steps, ok := desertMap.cycle(start, func(label string) bool { return label == "ZZZ" }).First()
+	if !ok {
+		return 0, errors.New("ZZZ is never reached from AAA")
+	}
+	return steps, nil
+}
+
+// ghostSteps returns the number of steps until the ghosts starting at all
+// nodes ending with A stand on nodes ending with Z together.
+func (desertMap Map) ghostSteps() (int, error) {
+	labels := maps.Keys(desertMap.nodes)
+	slices.Sort(labels)
+	cycles := []Cycle{}
+	for _, label := range labels {
+		if strings.HasSuffix(label, "A") {
+			c := desertMap.cycle(desertMap.nodes[label], func(label string) bool { return strings.HasSuffix(label, "Z") })
+			fmt.Fprintf(solver.Debug, "%s: %v\n", label, c)
+			cycles = append(cycles, c)
+		}
+	}
+	if len(cycles) == 0 {
+		return 0, errors.New("no node ending with A")
+	}
+	return together(cycles)
+}
+
+var nodePattern = regexp.MustCompile(`^(\w{3}) = \((\w{3}), (\w{3})\)$`)
+
+func parseMap(s string) (Map, error) {
+	lines := inputs.Lines(s)
+	instructions := lines[0]
+	if instructions.Text == "" {
+		return Map{}, instructions.MissingErrorf("missing instructions")
+	}
+	for i, move := range instructions.Text {
+		if move != 'L' && move != 'R' {
+			return Map{}, instructions.Slice(i, i+1).Errorf("want L or R, got")
+		}
+	}
+	if len(lines) < 3 || lines[1].Text != "" {
+		return Map{}, instructions.MissingErrorf("want empty line after instructions")
+	}
+
+	nodes := make(map[string]*Node)
+	// the first mention of every child, to report the undefined ones
+	mentions := []inputs.Span{}
+	get := func(label inputs.Span) *Node {
+		node, ok := nodes[label.Text]
+		if !ok {
+			node = &Node{label: label.Text}
+			nodes[label.Text] = node
+			mentions = append(mentions, label)
+		}
+		return node
+	}
+	defined := make(map[string]bool)
+	for _, line := range lines[2:] {
+		matches := nodePattern.FindStringSubmatchIndex(line.Text)
+		if matches == nil {
+			return Map{}, line.Errorf("want node like \"AAA = (BBB, CCC)\", got")
+		}
+		label := line.Slice(matches[2], matches[3])
+		if defined[label.Text] {
+			return Map{}, label.Errorf("node defined twice")
+		}
+		defined[label.Text] = true
+		node := get(label)
+		node.leftChild = get(line.Slice(matches[4], matches[5]))
+		node.rightChild = get(line.Slice(matches[6], matches[7]))
+	}
+	for _, mention := range mentions {
+		if !defined[mention.Text] {
+			return Map{}, mention.Errorf("undefined node")
+		}
+	}
+
+	return Map{
+		instructions: instructions.Text,
+		nodes:        nodes,
+	}, nil
+}
+
+type Map struct {
+	instructions string
+	nodes        map[string]*Node
+}
+
+type Node struct {
+	label      string
+	leftChild  *Node
+	rightChild *Node
+}
@@ -0,0 +1,78 @@
+package day08
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay08(t *testing.T) {
+	aoctest.Run(t, 8, aoctest.Parts(part1, part2))
+}
+
+// TestTogether compares the cycle analysis with walking all ghosts step by
+// step on random maps, whose cycles have offsets and several Z nodes.
+func TestTogether(t *testing.T) {
+	rng := rand.New(rand.NewSource(8))
+	for round := 0; round < 300; round++ {
+		nodeCount := 2 + rng.Intn(10)
+		labels := make([]string, nodeCount)
+		for i := range labels {
+			labels[i] = fmt.Sprintf("%02d%c", i, "AZX"[rng.Intn(3)])
+		}
+		labels[0] = "00A"
+		input := strings.Builder{}
+		for i := 1 + rng.Intn(4); i > 0; i-- {
+			input.WriteByte("LR"[rng.Intn(2)])
+		}
+		input.WriteString("\n\n")
+		for _, label := range labels {
+			fmt.Fprintf(&input, "%s = (%s, %s)\n", label, labels[rng.Intn(nodeCount)], labels[rng.Intn(nodeCount)])
+		}
+
+		want, wantErr := walkTogether(t, strings.TrimSuffix(input.String(), "\n"), 10000)
+		got, err := part2(strings.TrimSuffix(input.String(), "\n"))
+		switch {
+		case wantErr:
+			// no common step in the first 10000, there may be a later one
+			if err != nil && !errors.Is(err, ErrNeverTogether) || err == nil && got < 10000 {
+				t.Fatalf("round %d: got %d, %v, want none below 10000\n%s", round, got, err, input.String())
+			}
+		case err != nil || got != want:
+			t.Fatalf("round %d: got %d, %v, want %d\n%s", round, got, err, want, input.String())
+		}
+	}
+}
+
+// walkTogether moves all ghosts one step at a time.
+func walkTogether(t *testing.T, input string, limit int) (int, bool) {
+	m, err := parseMap(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ghosts := []*Node{}
+	for label, node := range m.nodes {
+		if strings.HasSuffix(label, "A") {
+			ghosts = append(ghosts, node)
+		}
+	}
+	for step := 0; step < limit; step++ {
+		done := true
+		for i, ghost := range ghosts {
+			done = done && strings.HasSuffix(ghost.label, "Z")
+			if m.instructions[step%len(m.instructions)] == 'L' {
+				ghosts[i] = ghost.leftChild
+			} else {
+				ghosts[i] = ghost.rightChild
+			}
+		}
+		if done {
+			return step, false
+		}
+	}
+	return 0, true
+}
@@ -0,0 +1,72 @@
+package day09
+
+import (
+	"fmt"
+
+	"aoc2023/inputs"
+	"aoc2023/sequence"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(9, solver.NewParsed(parseSequences, sumNext, sumPrevious))
+}
+
+func part1(input string) (int, error) {
+	sequences, err := parseSequences(input)
+	if err != nil {
+		return 0, err
+	}
+	return sumNext(sequences)
+}
+
+func part2(input string) (int, error) {
+	sequences, err := parseSequences(input)
+	if err != nil {
+		return 0, err
+	}
+	return sumPrevious(sequences)
+}
+
+func sumNext(sequences [][]int) (int, error) {
+	return sumAt(sequences, func(length int) int { return length })
+}
+
+func sumPrevious(sequences [][]int) (int, error) {
+	return sumAt(sequences, func(int) int { return -1 })
+}
+
+// sumAt fits a polynomial to every sequence and sums their values at the
+// index returned by index for the length of the sequence.
+func sumAt(sequences [][]int, index func(length int) int) (int, error) {
+	sum := 0
+	for i, values := range sequences {
+		p, err := sequence.Fit(values)
+		if err != nil {
+			return 0, fmt.Errorf("sequence %d: %w", i+1, err)
+		}
+		fmt.Fprintf(solver.Debug, "sequence %d: degree %d\n", i+1, p.Degree())
+		value := p.At(index(len(values)))
+		if !value.IsInt() || !value.Num().IsInt64() {
+			return 0, fmt.Errorf("sequence %d: value %v is not an int", i+1, value.RatString())
+		}
+		sum += int(value.Num().Int64())
+	}
+	return sum, nil
+}
+
+func parseSequences(input string) ([][]int, error) {
+	lines := inputs.Lines(input)
+	sequences := make([][]int, len(lines))
+	for i, line := range lines {
+		sequence, err := line.Ints()
+		if err != nil {
+			return nil, err
+		}
+		if len(sequence) == 0 {
+			return nil, line.MissingErrorf("empty sequence")
+		}
+		sequences[i] = sequence
+	}
+	return sequences, nil
+}
@@ -0,0 +1,11 @@
+package day09
+
+import (
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay09(t *testing.T) {
+	aoctest.Run(t, 9, aoctest.Parts(part1, part2))
+}
@@ -0,0 +1,248 @@
+package day10
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"aoc2023/grid"
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(10, solver.NewParsed(ParseLoop, Loop.farthest, Loop.enclosedTiles))
+}
+
+func part1(input string) (int, error) {
+	loop, err := ParseLoop(input)
+	if err != nil {
+		return 0, err
+	}
+	return loop.farthest()
+}
+
+func part2(input string) (int, error) {
+	loop, err := ParseLoop(input)
+	if err != nil {
+		return 0, err
+	}
+	return loop.enclosedTiles()
+}
+
+// farthest returns the number of steps to the tile farthest from the start,
+// which is halfway around the loop.
+func (l Loop) farthest() (int, error) {
+	return len(l.path) / 2, nil
+}
+
+// enclosedTiles counts the tiles inside the loop, checking the scanline
+// count against the shoelace formula.
+func (l Loop) enclosedTiles() (int, error) {
+	tilesCount := 0
+	l.inside().Each(func(_ grid.Point, inside bool) {
+		if inside {
+			tilesCount++
+		}
+	})
+	if enclosed := l.enclosed(); enclosed != tilesCount {
+		return 0, fmt.Errorf("scanline counts %d enclosed tiles, shoelace formula and Pick's theorem %d", tilesCount, enclosed)
+	}
+	return tilesCount, nil
+}
+
+// Loop is the main pipe loop through the start tile.
+type Loop struct {
+	tiles  grid.Grid[byte] // padded, with the start replaced by its pipe
+	start  grid.Point
+	path   []grid.Point // the tiles of the loop in walking order from the start
+	isLoop grid.Grid[bool]
+}
+
+// ParseLoop finds the loop through the start tile of the input.
+func ParseLoop(input string) (Loop, error) {
+	tiles, start, err := parseTiles(input)
+	if err != nil {
+		return Loop{}, err
+	}
+	return findLoop(tiles, start)
+}
+
+// LoopError is returned when not exactly one loop runs through the start.
+type LoopError struct {
+	Line, Col int // of the start tile
+	Loops     int // the number of loops through the start
+}
+
+func (e *LoopError) Error() string {
+	if e.Loops == 0 {
+		return fmt.Sprintf("line %d, column %d: no loop through the start tile", e.Line, e.Col)
+	}
+	return fmt.Sprintf("line %d, column %d: %d loops through the start tile", e.Line, e.Col, e.Loops)
+}
+
+// openings are the directions in which the pipes lead.
+var openings = map[byte][2]grid.Dir{
+	'|': {grid.Up, grid.Down},
+	'-': {grid.Left, grid.Right},
+	'L': {grid.Up, grid.Right},
+	'J': {grid.Up, grid.Left},
+	'7': {grid.Down, grid.Left},
+	'F': {grid.Down, grid.Right},
+}
+
+// findLoop follows the pipes from every neighbor of the start that leads
+// back to it. Pipes connect tiles in pairs, so a walk either dead-ends or
+// returns to the start, and loops that do not pass the start are never
+// entered. The start pipe is the one joining the first and last step of
+// the loop found.
+func findLoop(tiles grid.Grid[byte], start grid.Point) (Loop, error) {
+	loops := [][]grid.Point{}
+	startPipeTypes := []byte{}
+	for _, d := range grid.Dirs4 {
+		path, back, ok := walk(tiles, start, d)
+		if !ok {
+			continue
+		}
+		pipe := pipeBetween(d, back)
+		if slices.Contains(startPipeTypes, pipe) {
+			// the same loop walked the other way
+			continue
+		}
+		loops = append(loops, path)
+		startPipeTypes = append(startPipeTypes, pipe)
+	}
+	if len(loops) != 1 {
+		// the padding shifts the tiles by one, like line and column numbers
+		return Loop{}, &LoopError{Line: start.Row, Col: start.Col, Loops: len(loops)}
+	}
+
+	tiles = tiles.Clone()
+	tiles.Set(start, startPipeTypes[0])
+	isLoop := grid.New[bool](tiles.Rows(), tiles.Cols())
+	for _, p := range loops[0] {
+		isLoop.Set(p, true)
+	}
+	return Loop{
+		tiles:  tiles,
+		start:  start,
+		path:   loops[0],
+		isLoop: isLoop,
+	}, nil
+}
+
+// walk follows the pipes from the start in direction d. If it returns to
+// the start, it reports the tiles passed, starting with the start, and
+// the direction from the start to the last tile before it.
+func walk(tiles grid.Grid[byte], start grid.Point, d grid.Dir) ([]grid.Point, grid.Dir, bool) {
+	path := []grid.Point{start}
+	p := start
+	for {
+		next := p.Move(d)
+		if next == start {
+			return path, d.Reverse(), true
+		}
+		pipe, ok := openings[tiles.At(next)]
+		if !ok {
+			return nil, d, false
+		}
+		// leave the pipe through the opening we did not enter by
+		switch d.Reverse() {
+		case pipe[0]:
+			d = pipe[1]
+		case pipe[1]:
+			d = pipe[0]
+		default:
+			return nil, d, false
+		}
+		p = next
+		path = append(path, p)
+	}
+}
+
+// pipeBetween returns the pipe with openings in both directions.
+func pipeBetween(d1, d2 grid.Dir) byte {
+	for pipe, dirs := range openings {
+		if dirs == [2]grid.Dir{d1, d2} || dirs == [2]grid.Dir{d2, d1} {
+			return pipe
+		}
+	}
+	return '.'
+}
+
+// inside returns which tiles the loop encloses. Scanning a row from the
+// left, a tile is inside after crossing the loop an odd number of times,
+// where a horizontal run like L-7 is a crossing and L-J is not.
+func (l Loop) inside() grid.Grid[bool] {
+	inside := grid.New[bool](l.tiles.Rows(), l.tiles.Cols())
+	isInLoop := false
+	prevLoopBorder := byte('.')
+	for row := 0; row < l.tiles.Rows(); row++ {
+		isInLoop = false
+		for col, tile := range l.tiles.Row(row) {
+			if l.isLoop.At(grid.Point{Row: row, Col: col}) {
+				switch tile {
+				case '|':
+					fallthrough
+				case 'L':
+					fallthrough
+				case 'F':
+					isInLoop = !isInLoop
+					prevLoopBorder = tile
+				case 'J':
+					if prevLoopBorder != 'F' {
+						isInLoop = !isInLoop
+					}
+					prevLoopBorder = tile
+				case '7':
+					if prevLoopBorder != 'L' {
+						isInLoop = !isInLoop
+					}
+					prevLoopBorder = tile
+				}
+			} else if isInLoop {
+				inside.Set(grid.Point{Row: row, Col: col}, true)
+			}
+		}
+	}
+	return inside
+}
+
+// enclosed counts the tiles inside the loop without looking at them: the
+// shoelace formula gives the area A of the polygon through the centers of
+// the loop tiles, and by Pick's theorem A = i + b/2 - 1 for the i points
+// inside and the b points on the boundary.
+func (l Loop) enclosed() int {
+	twiceArea := 0
+	for i, p := range l.path {
+		next := l.path[(i+1)%len(l.path)]
+		twiceArea += p.Row*next.Col - next.Row*p.Col
+	}
+	if twiceArea < 0 {
+		twiceArea = -twiceArea
+	}
+	return (twiceArea-len(l.path))/2 + 1
+}
+
+// parseTiles returns the tiles surrounded by ground, so every neighbor of a
+// pipe exists, and the position of the start on them.
+func parseTiles(input string) (grid.Grid[byte], grid.Point, error) {
+	tiles, err := grid.ParseFunc(input, grid.OneOf("|-LJ7F.S"))
+	if err != nil {
+		return tiles, grid.Point{}, err
+	}
+	starts := []grid.Point{}
+	tiles.Each(func(p grid.Point, tile byte) {
+		if tile == 'S' {
+			starts = append(starts, p)
+		}
+	})
+	if len(starts) == 0 {
+		return tiles, grid.Point{}, errors.New("no start tile S")
+	}
+	if len(starts) > 1 {
+		p := starts[1]
+		return tiles, grid.Point{}, inputs.Lines(input)[p.Row].Slice(p.Col, p.Col+1).Errorf("second start tile")
+	}
+	return tiles.Pad(1, '.'), starts[0].Add(grid.Point{Row: 1, Col: 1}), nil
+}
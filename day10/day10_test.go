@@ -0,0 +1,51 @@
+package day10
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay10(t *testing.T) {
+	aoctest.Run(t, 10, aoctest.Parts(part1, part2))
+}
+
+func TestRender(t *testing.T) {
+	loop, err := ParseLoop("..........\n.S------7.\n.|F----7|.\n.||....||.\n.||....||.\n.|L-7F-J|.\n.|..||..|.\n.L--JL--J.\n..........")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `OOOOOOOOOO
+O┌──────┐O
+O│┌────┐│O
+O││OOOO││O
+O││OOOO││O
+O│└─┐┌─┘│O
+O│II││II│O
+O└──┘└──┘O
+OOOOOOOOOO
+`
+	got := strings.Builder{}
+	if err := loop.Render(&got, false); err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", got.String(), want)
+	}
+	if enclosed := loop.enclosed(); enclosed != 4 {
+		t.Errorf("enclosed() = %d, want 4", enclosed)
+	}
+}
+
+func TestLoopError(t *testing.T) {
+	_, err := ParseLoop("F-7..\n|.|..\nL-S-7\n..|.|\n..L-J")
+	loopErr := &LoopError{}
+	if !errors.As(err, &loopErr) {
+		t.Fatalf("got %v, want LoopError", err)
+	}
+	if *loopErr != (LoopError{Line: 3, Col: 3, Loops: 2}) {
+		t.Errorf("got %+v", *loopErr)
+	}
+}
@@ -0,0 +1,99 @@
+package day10
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"aoc2023/grid"
+)
+
+var boxDrawing = map[byte]string{
+	'|': "│",
+	'-': "─",
+	'L': "└",
+	'J': "┘",
+	'7': "┐",
+	'F': "┌",
+}
+
+const (
+	ansiReset   = "\x1b[0m"
+	ansiStart   = "\x1b[1;33m" // bold yellow
+	ansiInside  = "\x1b[42m"   // green background
+	ansiOutside = "\x1b[2m"    // dim
+)
+
+// Render writes the tiles with the loop in box-drawing characters and the
+// other tiles as I inside the loop and O outside. With color, ANSI escapes
+// highlight the start and the inside and outside tiles.
+func (l Loop) Render(w io.Writer, color bool) error {
+	inside := l.inside()
+	out := bufio.NewWriter(w)
+	// skip the padding
+	for row := 1; row < l.tiles.Rows()-1; row++ {
+		for col := 1; col < l.tiles.Cols()-1; col++ {
+			p := grid.Point{Row: row, Col: col}
+			tile, style := "O", ansiOutside
+			switch {
+			case l.isLoop.At(p):
+				tile, style = boxDrawing[l.tiles.At(p)], ""
+				if p == l.start {
+					style = ansiStart
+				}
+			case inside.At(p):
+				tile, style = "I", ansiInside
+			}
+			if color && style != "" {
+				tile = style + tile + ansiReset
+			}
+			out.WriteString(tile)
+		}
+		out.WriteByte('\n')
+	}
+	return out.Flush()
+}
+
+// svgTileSize is the width and height of a tile in the SVG in pixels.
+const svgTileSize = 10
+
+// SVG writes the tiles as an SVG image: inside tiles are green, outside
+// tiles gray and the loop is a line through the centers of its tiles,
+// starting at a red dot.
+func (l Loop) SVG(w io.Writer) error {
+	inside := l.inside()
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d">`+"\n",
+		(l.tiles.Cols()-2)*svgTileSize, (l.tiles.Rows()-2)*svgTileSize)
+	for row := 1; row < l.tiles.Rows()-1; row++ {
+		for col := 1; col < l.tiles.Cols()-1; col++ {
+			p := grid.Point{Row: row, Col: col}
+			if l.isLoop.At(p) {
+				continue
+			}
+			fill := "#e0e0e0"
+			if inside.At(p) {
+				fill = "#4caf50"
+			}
+			fmt.Fprintf(out, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"/>`+"\n",
+				(col-1)*svgTileSize, (row-1)*svgTileSize, svgTileSize, svgTileSize, fill)
+		}
+	}
+
+	points := make([]string, len(l.path))
+	for i, p := range l.path {
+		x, y := svgCenter(p)
+		points[i] = fmt.Sprintf("%d,%d", x, y)
+	}
+	fmt.Fprintf(out, `<polygon points="%s" fill="none" stroke="black" stroke-width="2"/>`+"\n", strings.Join(points, " "))
+	x, y := svgCenter(l.start)
+	fmt.Fprintf(out, `<circle cx="%d" cy="%d" r="%d" fill="red"/>`+"\n", x, y, svgTileSize/3)
+	fmt.Fprintln(out, "</svg>")
+	return out.Flush()
+}
+
+// svgCenter returns the center of the padded tile p in the SVG.
+func svgCenter(p grid.Point) (x, y int) {
+	return (p.Col-1)*svgTileSize + svgTileSize/2, (p.Row-1)*svgTileSize + svgTileSize/2
+}
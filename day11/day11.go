@@ -0,0 +1,90 @@
+package day11
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+
+	"aoc2023/grid"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(11, solver.NewParsed(parseUniverse, func(universe grid.Grid[byte]) (*big.Int, error) {
+		return expandedDistances(universe, 2)
+	}, func(universe grid.Grid[byte]) (*big.Int, error) {
+		return expandedDistances(universe, 1000000)
+	}))
+}
+
+func part1(input string) (*big.Int, error) {
+	return part2(input, 2)
+}
+
+func part2(input string, expansionSize int) (*big.Int, error) {
+	universe, err := parseUniverse(input)
+	if err != nil {
+		return nil, err
+	}
+	return expandedDistances(universe, expansionSize)
+}
+
+func parseUniverse(input string) (grid.Grid[byte], error) {
+	return grid.ParseFunc(input, grid.OneOf(".#"))
+}
+
+func expandedDistances(universe grid.Grid[byte], expansionSize int) (*big.Int, error) {
+	if expansionSize < 1 {
+		return nil, fmt.Errorf("expansion size %d is less than 1", expansionSize)
+	}
+	return sumOfDistances(universe, expansionSize), nil
+}
+
+// sumOfDistances sums the distances between all pairs of galaxies after
+// every empty row and column grew to expansionSize rows or columns. The
+// Manhattan distance adds up the distances in rows and in columns, which
+// are summed separately.
+func sumOfDistances(universe grid.Grid[byte], expansionSize int) *big.Int {
+	rows, cols := []int{}, []int{}
+	universe.Each(func(p grid.Point, tile byte) {
+		if tile == '#' {
+			rows = append(rows, p.Row)
+			cols = append(cols, p.Col)
+		}
+	})
+	sum := sumOfAxisDistances(rows, universe.Rows(), expansionSize)
+	return sum.Add(sum, sumOfAxisDistances(cols, universe.Cols(), expansionSize))
+}
+
+// sumOfAxisDistances sums |x_i - x_j| over all pairs of galaxy coordinates
+// on an axis of the given size, after expansion. In sorted order the i-th
+// of n coordinates is added i times and subtracted n-1-i times. An expanded
+// coordinate is x + e*(expansionSize-1) for the e empty lines before x,
+// which preserves the order, so the sum is A + B*(expansionSize-1) for the
+// sums A of the coordinates and B of the empty line counts.
+func sumOfAxisDistances(coords []int, size, expansionSize int) *big.Int {
+	slices.Sort(coords)
+
+	// emptyBefore[x] is the number of empty lines before x
+	occupied := make([]bool, size)
+	for _, x := range coords {
+		occupied[x] = true
+	}
+	emptyBefore := make([]int, size)
+	for x := 1; x < size; x++ {
+		emptyBefore[x] = emptyBefore[x-1]
+		if !occupied[x-1] {
+			emptyBefore[x]++
+		}
+	}
+
+	coordSum, emptySum := new(big.Int), new(big.Int)
+	weight, term := new(big.Int), new(big.Int)
+	for i, x := range coords {
+		weight.SetInt64(int64(2*i - len(coords) + 1))
+		coordSum.Add(coordSum, term.Mul(weight, big.NewInt(int64(x))))
+		emptySum.Add(emptySum, term.Mul(weight, big.NewInt(int64(emptyBefore[x]))))
+	}
+	emptySum.Mul(emptySum, big.NewInt(int64(expansionSize-1)))
+	return coordSum.Add(coordSum, emptySum)
+}
@@ -0,0 +1,79 @@
+package day11
+
+import (
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"aoc2023/aoctest"
+	"aoc2023/grid"
+)
+
+func TestDay11(t *testing.T) {
+	aoctest.Run(t, 11, func(c aoctest.Case, input string) (any, error) {
+		if c.Part == 1 {
+			return part1(input)
+		}
+		return part2(input, c.Param("expansion"))
+	})
+}
+
+// TestSumOfDistances compares the prefix sums with expanding the universe
+// and measuring every pair.
+func TestSumOfDistances(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	for round := 0; round < 200; round++ {
+		rows, cols := 1+rng.Intn(8), 1+rng.Intn(8)
+		lines := make([]string, rows)
+		for row := range lines {
+			line := []byte(strings.Repeat(".", cols))
+			for col := range line {
+				if rng.Intn(5) == 0 {
+					line[col] = '#'
+				}
+			}
+			lines[row] = string(line)
+		}
+		universe, err := grid.Parse(strings.Join(lines, "\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		expansionSize := 1 + rng.Intn(5)
+		want := big.NewInt(int64(sumOfDistancesByPairs(universe, expansionSize)))
+		if got := sumOfDistances(universe, expansionSize); got.Cmp(want) != 0 {
+			t.Fatalf("expansion %d: got %v, want %v\n%s", expansionSize, got, want, universe)
+		}
+	}
+}
+
+func sumOfDistancesByPairs(universe grid.Grid[byte], expansionSize int) int {
+	galaxies := []grid.Point{}
+	universe.Each(func(p grid.Point, tile byte) {
+		if tile == '#' {
+			galaxies = append(galaxies, p)
+		}
+	})
+	// the expanded position of every row and column
+	rows := make([]int, universe.Rows())
+	for row := 1; row < len(rows); row++ {
+		rows[row] = rows[row-1] + 1
+		if !strings.Contains(string(universe.Row(row-1)), "#") {
+			rows[row] += expansionSize - 1
+		}
+	}
+	cols := make([]int, universe.Cols())
+	for col := 1; col < len(cols); col++ {
+		cols[col] = cols[col-1] + 1
+		if !strings.Contains(string(universe.Col(col-1)), "#") {
+			cols[col] += expansionSize - 1
+		}
+	}
+	sum := 0
+	for i, g1 := range galaxies {
+		for _, g2 := range galaxies[i+1:] {
+			sum += grid.Point{Row: rows[g1.Row], Col: cols[g1.Col]}.Manhattan(grid.Point{Row: rows[g2.Row], Col: cols[g2.Col]})
+		}
+	}
+	return sum
+}
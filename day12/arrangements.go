@@ -0,0 +1,121 @@
+package day12
+
+import (
+	"math/rand"
+	"strings"
+)
+
+// Arrangements iterates over the arrangements of a record, like "#.#.###"
+// for "???.### 1,1,3". Each arrangement is built only when it is reached,
+// from the counts of the arrangements that precede it.
+type Arrangements struct {
+	counts  *counts
+	next    int
+	current string
+}
+
+// Arrangements returns an iterator over the arrangements of the record.
+func (c ConditionRecord) Arrangements() *Arrangements {
+	return &Arrangements{counts: c.counts()}
+}
+
+// Next advances to the next arrangement and reports whether there is one.
+func (a *Arrangements) Next() bool {
+	if a.next >= a.counts.from(State{0, 0}) {
+		a.current = ""
+		return false
+	}
+	a.current = a.counts.arrangement(a.next)
+	a.next++
+	return true
+}
+
+// Arrangement returns the current arrangement.
+func (a *Arrangements) Arrangement() string {
+	return a.current
+}
+
+// Sample returns an arrangement chosen uniformly at random, or false if
+// there is none.
+func (c ConditionRecord) Sample(rng *rand.Rand) (string, bool) {
+	n := c.counts()
+	total := n.from(State{0, 0})
+	if total == 0 {
+		return "", false
+	}
+	return n.arrangement(rng.Intn(total)), true
+}
+
+// arrangement returns the arrangement of the given rank, where the
+// arrangements with an operational spring at a '?' come before those with
+// a damaged one.
+func (n *counts) arrangement(rank int) string {
+	c := n.record
+	arrangement := make([]byte, 0, len(c.springs))
+	state := State{0, 0}
+	for state.springIndex < len(c.springs) {
+		if next, ok := c.skip(state); ok {
+			skipped := n.from(next)
+			if rank < skipped {
+				arrangement = append(arrangement, '.')
+				state = next
+				continue
+			}
+			rank -= skipped
+		}
+		// the rank is below the count of the state, so the rest place a group
+		next, _ := c.place(state)
+		arrangement = append(arrangement, strings.Repeat("#", c.groupSizes[state.groupIndex])...)
+		if next.springIndex <= len(c.springs) {
+			arrangement = append(arrangement, '.')
+		}
+		state = next
+	}
+	return string(arrangement)
+}
+
+// DamagedProbabilities returns, for every spring, the fraction of the
+// arrangements in which it is damaged: 1 for '#', 0 for '.' and anything
+// in between for '?'. It returns nil if there is no arrangement.
+func (c ConditionRecord) DamagedProbabilities() []float64 {
+	n := c.counts()
+	total := n.from(State{0, 0})
+	if total == 0 {
+		return nil
+	}
+
+	// ways[i][g] is the number of ways to reach State{i, g} from the start
+	ways := make([][]int, len(c.springs)+2)
+	for i := range ways {
+		ways[i] = make([]int, len(c.groupSizes)+1)
+	}
+	ways[0][0] = 1
+	// damagedDiff[i] changes the number of arrangements with spring i damaged
+	damagedDiff := make([]int, len(c.springs)+1)
+	for i := 0; i < len(c.springs); i++ {
+		for g, w := range ways[i] {
+			if w == 0 {
+				continue
+			}
+			state := State{i, g}
+			if next, ok := c.skip(state); ok {
+				ways[next.springIndex][next.groupIndex] += w
+			}
+			if next, ok := c.place(state); ok {
+				ways[next.springIndex][next.groupIndex] += w
+				// arrangements from the start through this group
+				through := w * n.from(next)
+				damagedDiff[i] += through
+				damagedDiff[i+c.groupSizes[g]] -= through
+			}
+		}
+	}
+
+	probabilities := make([]float64, len(c.springs))
+	damaged := 0
+	for i := range probabilities {
+		damaged += damagedDiff[i]
+		probabilities[i] = float64(damaged) / float64(total)
+	}
+	return probabilities
+}
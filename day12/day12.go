@@ -0,0 +1,182 @@
+package day12
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(12, solver.NewParsed(parseRecords, sumArrangements, sumUnfoldedArrangements))
+}
+
+func part1(input string) (int, error) {
+	records, err := parseRecords(input)
+	if err != nil {
+		return 0, err
+	}
+	return sumArrangements(records)
+}
+
+func part2(input string) (int, error) {
+	records, err := parseRecords(input)
+	if err != nil {
+		return 0, err
+	}
+	return sumUnfoldedArrangements(records)
+}
+
+func sumArrangements(records []ConditionRecord) (int, error) {
+	sum := 0
+	for _, record := range records {
+		sum += record.countArrangements()
+	}
+	return sum, nil
+}
+
+func sumUnfoldedArrangements(records []ConditionRecord) (int, error) {
+	// every record owns its memo, so they can be counted concurrently
+	counts := make([]int, len(records))
+	indices := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < runtime.GOMAXPROCS(0); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				counts[i] = records[i].unfold(5).countArrangements()
+			}
+		}()
+	}
+	for i := range records {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	sum := 0
+	for _, count := range counts {
+		sum += count
+	}
+	return sum, nil
+}
+
+func parseRecords(input string) ([]ConditionRecord, error) {
+	lines := inputs.Lines(input)
+	records := make([]ConditionRecord, len(lines))
+	for i, line := range lines {
+		var err error
+		records[i], err = parseRecord(line)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
+
+func parseRecord(s inputs.Span) (ConditionRecord, error) {
+	springs, rawGroupSizes, err := s.Cut(" ")
+	if err != nil {
+		return ConditionRecord{}, err
+	}
+	for i := range springs.Text {
+		if strings.IndexByte(".#?", springs.Text[i]) < 0 {
+			return ConditionRecord{}, springs.Slice(i, i+1).Errorf("unknown spring condition")
+		}
+	}
+	groupSizes, err := rawGroupSizes.IntsSep(",")
+	if err != nil {
+		return ConditionRecord{}, err
+	}
+	return ConditionRecord{
+		springs:    springs.Text,
+		groupSizes: groupSizes,
+	}, nil
+}
+
+type ConditionRecord struct {
+	springs    string
+	groupSizes []int
+}
+
+func (c ConditionRecord) unfold(times int) ConditionRecord {
+	newGroupSizes := make([]int, len(c.groupSizes)*times)
+	for i := 0; i < times; i++ {
+		copy(newGroupSizes[i*len(c.groupSizes):], c.groupSizes)
+	}
+	return ConditionRecord{
+		springs:    strings.Repeat(c.springs+"?", times)[:len(c.springs)*times+times-1],
+		groupSizes: newGroupSizes,
+	}
+}
+
+// State is a position in a record: the next spring to decide on and the
+// next group to place.
+type State struct {
+	springIndex, groupIndex int
+}
+
+// counts memoizes the number of arrangements of a record from every state.
+type counts struct {
+	record ConditionRecord
+	memo   map[State]int
+}
+
+func (c ConditionRecord) counts() *counts {
+	return &counts{record: c, memo: make(map[State]int)}
+}
+
+func (c ConditionRecord) countArrangements() int {
+	return c.counts().from(State{0, 0})
+}
+
+// from returns the number of arrangements of the springs from the state on.
+func (n *counts) from(state State) int {
+	if result, ok := n.memo[state]; ok {
+		return result
+	}
+	result := 0
+	if n.record.done(state) {
+		result = 1
+	}
+	if next, ok := n.record.skip(state); ok {
+		result += n.from(next)
+	}
+	if next, ok := n.record.place(state); ok {
+		result += n.from(next)
+	}
+	n.memo[state] = result
+	return result
+}
+
+// done reports whether all springs are decided and all groups placed.
+func (c ConditionRecord) done(state State) bool {
+	return state.springIndex >= len(c.springs) && state.groupIndex == len(c.groupSizes)
+}
+
+// skip decides that the next spring is operational.
+func (c ConditionRecord) skip(state State) (State, bool) {
+	if state.springIndex >= len(c.springs) || c.springs[state.springIndex] == '#' {
+		return state, false
+	}
+	return State{state.springIndex + 1, state.groupIndex}, true
+}
+
+// place puts the next group of damaged springs at the next spring,
+// followed by an operational one unless the springs end.
+func (c ConditionRecord) place(state State) (State, bool) {
+	springIndex, groupIndex := state.springIndex, state.groupIndex
+	if springIndex >= len(c.springs) || groupIndex >= len(c.groupSizes) {
+		return state, false
+	}
+	springEndIndex := springIndex + c.groupSizes[groupIndex]
+	if springEndIndex > len(c.springs) ||
+		strings.Contains(c.springs[springIndex:springEndIndex], ".") ||
+		springEndIndex < len(c.springs) && c.springs[springEndIndex] == '#' {
+		return state, false
+	}
+	return State{springEndIndex + 1, groupIndex + 1}, true
+}
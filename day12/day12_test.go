@@ -0,0 +1,113 @@
+package day12
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"aoc2023/aoctest"
+	"aoc2023/inputs"
+)
+
+func TestDay12(t *testing.T) {
+	aoctest.Run(t, 12, aoctest.Parts(part1, part2))
+}
+
+func record(t *testing.T, line string) ConditionRecord {
+	t.Helper()
+	c, err := parseRecord(inputs.Lines(line)[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// allArrangements tries every assignment of the '?' springs.
+func allArrangements(c ConditionRecord) []string {
+	arrangements := []string{}
+	var try func(springs []byte, i int)
+	try = func(springs []byte, i int) {
+		if i == len(springs) {
+			groupSizes := []int{}
+			for _, group := range strings.FieldsFunc(string(springs), func(r rune) bool { return r == '.' }) {
+				groupSizes = append(groupSizes, len(group))
+			}
+			if slices.Equal(groupSizes, c.groupSizes) {
+				arrangements = append(arrangements, string(springs))
+			}
+			return
+		}
+		if springs[i] != '?' {
+			try(springs, i+1)
+			return
+		}
+		for _, condition := range []byte(".#") {
+			springs[i] = condition
+			try(springs, i+1)
+		}
+		springs[i] = '?'
+	}
+	try([]byte(c.springs), 0)
+	return arrangements
+}
+
+func TestArrangements(t *testing.T) {
+	for _, line := range []string{"???.### 1,1,3", ".??..??...?##. 1,1,3", "?###???????? 3,2,1", "?#?# 3", "#.# 2"} {
+		c := record(t, line)
+		want := allArrangements(c)
+		got := []string{}
+		for arrangements := c.Arrangements(); arrangements.Next(); {
+			got = append(got, arrangements.Arrangement())
+		}
+		if len(got) != c.countArrangements() || !slices.Equal(got, want) {
+			t.Errorf("%s: got %v, want %v", line, got, want)
+		}
+	}
+}
+
+func TestSample(t *testing.T) {
+	c := record(t, "?###???????? 3,2,1")
+	rng := rand.New(rand.NewSource(12))
+	seen := map[string]int{}
+	for i := 0; i < 1000; i++ {
+		arrangement, ok := c.Sample(rng)
+		if !ok {
+			t.Fatal("no sample")
+		}
+		seen[arrangement]++
+	}
+	// 10 arrangements, each drawn about 100 times
+	want := allArrangements(c)
+	if len(seen) != len(want) {
+		t.Fatalf("sampled %d arrangements, want %d", len(seen), len(want))
+	}
+	for _, arrangement := range want {
+		if seen[arrangement] < 60 || seen[arrangement] > 140 {
+			t.Errorf("%s sampled %d times, want about 100", arrangement, seen[arrangement])
+		}
+	}
+	if _, ok := record(t, "#.# 3").Sample(rng); ok {
+		t.Error("sampled an impossible record")
+	}
+}
+
+func TestDamagedProbabilities(t *testing.T) {
+	for _, line := range []string{".??..??...?##. 1,1,3", "?###???????? 3,2,1", "??????? 2,1"} {
+		c := record(t, line)
+		arrangements := allArrangements(c)
+		got := c.DamagedProbabilities()
+		for i := range c.springs {
+			damaged := 0
+			for _, arrangement := range arrangements {
+				if arrangement[i] == '#' {
+					damaged++
+				}
+			}
+			if want := float64(damaged) / float64(len(arrangements)); math.Abs(got[i]-want) > 1e-9 {
+				t.Errorf("%s: spring %d is damaged with probability %v, want %v", line, i, got[i], want)
+			}
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package day13
+
+import (
+	"fmt"
+
+	"aoc2023/grid"
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(13, solver.NewParsed(parsePatterns, summarize(0), summarize(1)))
+}
+
+func part1(input string) (int, error) {
+	patterns, err := parsePatterns(input)
+	if err != nil {
+		return 0, err
+	}
+	return summarize(0)(patterns)
+}
+
+func part2(input string) (int, error) {
+	patterns, err := parsePatterns(input)
+	if err != nil {
+		return 0, err
+	}
+	return summarize(1)(patterns)
+}
+
+// summarize returns the sum of the reflection values of all patterns with
+// the given number of smudges.
+func summarize(smudges int) func(patterns []Pattern) (int, error) {
+	return func(patterns []Pattern) (int, error) {
+		sum := 0
+		for _, pattern := range patterns {
+			reflection, err := pattern.Reflection(smudges, Vertical, Horizontal)
+			if err != nil {
+				return 0, err
+			}
+			fmt.Fprintf(solver.Debug, "pattern at line %d: %v\n", pattern.line, reflection.Symmetry)
+			sum += reflection.Value()
+		}
+		return sum, nil
+	}
+}
+
+func parsePatterns(s string) ([]Pattern, error) {
+	blocks := inputs.Blocks(s)
+	patterns := make([]Pattern, len(blocks))
+	for i, block := range blocks {
+		tiles, err := grid.ParseLines(block, grid.OneOf(".#"))
+		if err != nil {
+			return nil, fmt.Errorf("pattern %d: %w", i+1, err)
+		}
+		patterns[i] = Pattern{
+			tiles: tiles,
+			line:  block[0].Line,
+		}
+	}
+	return patterns, nil
+}
+
+type Pattern struct {
+	tiles grid.Grid[byte]
+	// line is the first line of the pattern in the input.
+	line int
+}
+
+// Axis is the kind of a symmetry.
+type Axis int
+
+const (
+	// Vertical mirrors the pattern at a line between two columns.
+	Vertical Axis = iota
+	// Horizontal mirrors the pattern at a line between two rows.
+	Horizontal
+	// Rotational turns the pattern by 180 degrees about its center.
+	Rotational
+	// Diagonal mirrors a square pattern at the diagonal from the top left.
+	Diagonal
+	// AntiDiagonal mirrors a square pattern at the diagonal from the top right.
+	AntiDiagonal
+)
+
+func (a Axis) String() string {
+	return [...]string{"vertical", "horizontal", "rotational", "diagonal", "anti-diagonal"}[a]
+}
+
+// Symmetry is a way to map the pattern onto itself.
+type Symmetry struct {
+	Axis Axis
+	// Index is the number of columns left of a vertical mirror line or of
+	// rows above a horizontal one; it is 0 for the other axes.
+	Index int
+	// Smudges are the tiles that differ from their image. Of each such
+	// pair, the tile first in reading order is listed.
+	Smudges []grid.Point
+}
+
+func (s Symmetry) String() string {
+	switch s.Axis {
+	case Vertical:
+		return fmt.Sprintf("vertical line after column %d, smudges at %v", s.Index, s.Smudges)
+	case Horizontal:
+		return fmt.Sprintf("horizontal line after row %d, smudges at %v", s.Index, s.Smudges)
+	}
+	return fmt.Sprintf("%v, smudges at %v", s.Axis, s.Smudges)
+}
+
+// Reflection is the symmetry found in a pattern.
+type Reflection struct {
+	Symmetry
+	// Candidates are all symmetries that were considered, with their smudges.
+	Candidates []Symmetry
+}
+
+// Value returns the number of columns left of a vertical line, or 100
+// times the number of rows above a horizontal line.
+func (r Reflection) Value() int {
+	if r.Axis == Horizontal {
+		return 100 * r.Index
+	}
+	return r.Index
+}
+
+// Reflection returns the first symmetry of the given axes, in that order,
+// with exactly the given number of smudges.
+func (p Pattern) Reflection(smudges int, axes ...Axis) (Reflection, error) {
+	candidates := p.Candidates(axes...)
+	for _, candidate := range candidates {
+		if len(candidate.Smudges) == smudges {
+			return Reflection{candidate, candidates}, nil
+		}
+	}
+	return Reflection{Candidates: candidates}, fmt.Errorf("pattern at line %d: no reflection with %d smudges", p.line, smudges)
+}
+
+// Candidates returns all symmetries of the given axes with their smudges.
+// The diagonals are only considered for square patterns.
+func (p Pattern) Candidates(axes ...Axis) []Symmetry {
+	rows, cols := p.tiles.Rows(), p.tiles.Cols()
+	candidates := []Symmetry{}
+	for _, axis := range axes {
+		switch axis {
+		case Vertical:
+			candidates = append(candidates, p.verticalReflections()...)
+		case Horizontal:
+			candidates = append(candidates, p.horizontalReflections()...)
+		case Rotational:
+			candidates = append(candidates, p.symmetry(Rotational, 0, func(t grid.Point) grid.Point {
+				return grid.Point{Row: rows - 1 - t.Row, Col: cols - 1 - t.Col}
+			}))
+		case Diagonal:
+			if rows == cols {
+				candidates = append(candidates, p.symmetry(Diagonal, 0, func(t grid.Point) grid.Point {
+					return grid.Point{Row: t.Col, Col: t.Row}
+				}))
+			}
+		case AntiDiagonal:
+			if rows == cols {
+				candidates = append(candidates, p.symmetry(AntiDiagonal, 0, func(t grid.Point) grid.Point {
+					return grid.Point{Row: cols - 1 - t.Col, Col: rows - 1 - t.Row}
+				}))
+			}
+		}
+	}
+	return candidates
+}
+
+func (p Pattern) verticalReflections() []Symmetry {
+	reflections := []Symmetry{}
+	for colsLeft := 1; colsLeft < p.tiles.Cols(); colsLeft++ {
+		reflections = append(reflections, p.symmetry(Vertical, colsLeft, func(t grid.Point) grid.Point {
+			return grid.Point{Row: t.Row, Col: 2*colsLeft - 1 - t.Col}
+		}))
+	}
+	return reflections
+}
+
+func (p Pattern) horizontalReflections() []Symmetry {
+	reflections := []Symmetry{}
+	for rowsAbove := 1; rowsAbove < p.tiles.Rows(); rowsAbove++ {
+		reflections = append(reflections, p.symmetry(Horizontal, rowsAbove, func(t grid.Point) grid.Point {
+			return grid.Point{Row: 2*rowsAbove - 1 - t.Row, Col: t.Col}
+		}))
+	}
+	return reflections
+}
+
+// symmetry compares every tile with its image under mirror. Tiles whose
+// image lies outside of the pattern, like those beyond the shorter side of
+// a mirror line, are not compared.
+func (p Pattern) symmetry(axis Axis, index int, mirror func(grid.Point) grid.Point) Symmetry {
+	smudges := []grid.Point{}
+	p.tiles.Each(func(t grid.Point, tile byte) {
+		image := mirror(t)
+		inReadingOrder := t.Row < image.Row || t.Row == image.Row && t.Col < image.Col
+		if inReadingOrder && p.tiles.In(image) && p.tiles.At(image) != tile {
+			smudges = append(smudges, t)
+		}
+	})
+	return Symmetry{Axis: axis, Index: index, Smudges: smudges}
+}
@@ -0,0 +1,65 @@
+package day13
+
+import (
+	"slices"
+	"testing"
+
+	"aoc2023/aoctest"
+	"aoc2023/grid"
+)
+
+func TestDay13(t *testing.T) {
+	aoctest.Run(t, 13, aoctest.Parts(part1, part2))
+}
+
+func TestReflection(t *testing.T) {
+	patterns, err := parsePatterns("#.##..##.\n..#.##.#.\n##......#\n##......#\n..#.##.#.\n..##..##.\n#.#.##.#.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := patterns[0].Reflection(1, Vertical, Horizontal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Axis != Horizontal || r.Index != 3 || !slices.Equal(r.Smudges, []grid.Point{{Row: 0, Col: 0}}) {
+		t.Errorf("got %v", r.Symmetry)
+	}
+	if len(r.Candidates) != 8+6 {
+		t.Errorf("got %d candidates, want 14", len(r.Candidates))
+	}
+	if r.Candidates[4].Axis != Vertical || r.Candidates[4].Index != 5 || len(r.Candidates[4].Smudges) != 0 {
+		t.Errorf("candidate 4 is %v, want the mirror line of part 1", r.Candidates[4])
+	}
+}
+
+func TestSymmetries(t *testing.T) {
+	tests := []struct {
+		pattern string
+		axis    Axis
+		smudges []grid.Point
+	}{
+		{"#..\n.#.\n..#", Rotational, []grid.Point{}},
+		{"#..\n.#.\n..#", Diagonal, []grid.Point{}},
+		{"#..\n.#.\n..#", AntiDiagonal, []grid.Point{}},
+		{"##.\n.#.\n..#", Diagonal, []grid.Point{{Row: 0, Col: 1}}},
+		{"##..\n.#..", Rotational, []grid.Point{{Row: 0, Col: 0}, {Row: 0, Col: 1}, {Row: 0, Col: 2}}},
+	}
+	for _, tt := range tests {
+		patterns, err := parsePatterns(tt.pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := patterns[0].Reflection(len(tt.smudges), tt.axis)
+		if err != nil {
+			t.Errorf("%q: %v", tt.pattern, err)
+			continue
+		}
+		if !slices.Equal(r.Smudges, tt.smudges) {
+			t.Errorf("%q: %v smudges at %v, want %v", tt.pattern, tt.axis, r.Smudges, tt.smudges)
+		}
+	}
+	patterns, _ := parsePatterns("##.\n.#.")
+	if got := patterns[0].Candidates(Diagonal, AntiDiagonal); len(got) != 0 {
+		t.Errorf("non-square pattern has diagonal candidates %v", got)
+	}
+}
@@ -0,0 +1,236 @@
+package day14
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math/bits"
+	"slices"
+
+	"aoc2023/grid"
+)
+
+// bitboard stores the round rocks of a dish as one bit per tile, row by
+// row. Tilting moves the round rocks of every segment between two cube
+// rocks at once: it counts them and refills the segment from the side
+// they roll to. Columns are tilted as the rows of the transposed board,
+// so the bits of a segment are always next to each other.
+type bitboard struct {
+	round bitmatrix
+	// rowSegments[r] are the ranges of columns of row r without cube
+	// rocks, colSegments[c] the ranges of rows of column c.
+	rowSegments [][]segment
+	colSegments [][]segment
+}
+
+type segment struct {
+	start, end int
+}
+
+func newBitboard(d Dish) bitboard {
+	rows, cols := d.positions.Rows(), d.positions.Cols()
+	b := bitboard{round: newBitmatrix(rows, cols)}
+	d.positions.Each(func(p grid.Point, position byte) {
+		if position == 'O' {
+			b.round.set(p.Row, p.Col)
+		}
+	})
+	isCube := func(row, col int) bool {
+		return d.positions.At(grid.Point{Row: row, Col: col}) == '#'
+	}
+	b.rowSegments = make([][]segment, rows)
+	for row := range b.rowSegments {
+		b.rowSegments[row] = segments(cols, func(col int) bool { return isCube(row, col) })
+	}
+	b.colSegments = make([][]segment, cols)
+	for col := range b.colSegments {
+		b.colSegments[col] = segments(rows, func(row int) bool { return isCube(row, col) })
+	}
+	return b
+}
+
+// segments returns the non-empty ranges of [0, n) between the blocked
+// positions.
+func segments(n int, blocked func(i int) bool) []segment {
+	result := []segment{}
+	start := 0
+	for i := 0; i <= n; i++ {
+		if i == n || blocked(i) {
+			if start < i {
+				result = append(result, segment{start, i})
+			}
+			start = i + 1
+		}
+	}
+	return result
+}
+
+func (b bitboard) clone() bitboard {
+	b.round.words = slices.Clone(b.round.words)
+	return b
+}
+
+// dish converts the bitboard back; the cube rocks are where the segments
+// are not.
+func (b bitboard) dish() Dish {
+	positions := grid.Filled(b.round.rows, b.round.cols, byte('#'))
+	for row, segments := range b.rowSegments {
+		for _, s := range segments {
+			for col := s.start; col < s.end; col++ {
+				positions.Set(grid.Point{Row: row, Col: col}, '.')
+				if b.round.has(row, col) {
+					positions.Set(grid.Point{Row: row, Col: col}, 'O')
+				}
+			}
+		}
+	}
+	return Dish{positions}
+}
+
+// spinCycle tilts north, west, south and east.
+func (b *bitboard) spinCycle() {
+	columns := b.round.transpose()
+	tilt(columns, b.colSegments, true)
+	rows := columns.transpose()
+	tilt(rows, b.rowSegments, true)
+	columns = rows.transpose()
+	tilt(columns, b.colSegments, false)
+	b.round = columns.transpose()
+	tilt(b.round, b.rowSegments, false)
+}
+
+// tilt rolls the round rocks in every segment of the rows of m to its
+// start, or to its end.
+func tilt(m bitmatrix, segments [][]segment, toStart bool) {
+	for row, segments := range segments {
+		line := m.row(row)
+		for _, s := range segments {
+			count := countRange(line, s.start, s.end)
+			if count == 0 {
+				continue
+			}
+			fillRange(line, s.start, s.end, false)
+			if toStart {
+				fillRange(line, s.start, s.start+count, true)
+			} else {
+				fillRange(line, s.end-count, s.end, true)
+			}
+		}
+	}
+}
+
+// totalLoad sums the distances of the round rocks from the south edge.
+func (b bitboard) totalLoad() int {
+	sum := 0
+	for row := 0; row < b.round.rows; row++ {
+		count := 0
+		for _, word := range b.round.row(row) {
+			count += bits.OnesCount64(word)
+		}
+		sum += count * (b.round.rows - row)
+	}
+	return sum
+}
+
+func (b bitboard) hash() uint64 {
+	h := fnv.New64a()
+	buf := make([]byte, 0, 8*len(b.round.words))
+	for _, word := range b.round.words {
+		buf = binary.LittleEndian.AppendUint64(buf, word)
+	}
+	h.Write(buf)
+	return h.Sum64()
+}
+
+// bitmatrix is a matrix of bits stored row by row, every row starting at
+// a new 64-bit word; bit c%64 of word c/64 of a row is column c.
+type bitmatrix struct {
+	rows, cols int
+	stride     int // words per row
+	words      []uint64
+}
+
+func newBitmatrix(rows, cols int) bitmatrix {
+	stride := (cols + 63) / 64
+	return bitmatrix{rows, cols, stride, make([]uint64, rows*stride)}
+}
+
+func (m bitmatrix) row(row int) []uint64 {
+	return m.words[row*m.stride : (row+1)*m.stride]
+}
+
+func (m bitmatrix) has(row, col int) bool {
+	return m.row(row)[col/64]&(1<<(col%64)) != 0
+}
+
+func (m bitmatrix) set(row, col int) {
+	m.row(row)[col/64] |= 1 << (col % 64)
+}
+
+// transpose returns the matrix mirrored at its main diagonal, transposing
+// blocks of 64×64 bits at a time.
+func (m bitmatrix) transpose() bitmatrix {
+	result := newBitmatrix(m.cols, m.rows)
+	block := [64]uint64{}
+	for blockRow := 0; blockRow < m.rows; blockRow += 64 {
+		for blockCol := 0; blockCol < m.stride; blockCol++ {
+			for i := range block {
+				block[i] = 0
+				if blockRow+i < m.rows {
+					block[i] = m.words[(blockRow+i)*m.stride+blockCol]
+				}
+			}
+			transpose64(&block)
+			for i := range block {
+				if row := blockCol*64 + i; row < result.rows {
+					result.words[row*result.stride+blockRow/64] = block[i]
+				}
+			}
+		}
+	}
+	return result
+}
+
+// transpose64 transposes a 64×64 bit matrix in place by swapping ever
+// smaller off-diagonal blocks, from 32×32 down to single bits.
+func transpose64(a *[64]uint64) {
+	mask := uint64(0x00000000FFFFFFFF)
+	for j := 32; j != 0; j, mask = j>>1, mask^(mask<<(j>>1)) {
+		for k := 0; k < 64; k = (k + j + 1) &^ j {
+			t := ((a[k] >> j) ^ a[k+j]) & mask
+			a[k] ^= t << j
+			a[k+j] ^= t
+		}
+	}
+}
+
+// rangeMask returns the bits of word w that lie in [from, to).
+func rangeMask(w, from, to int) uint64 {
+	mask := ^uint64(0)
+	if start := from - w*64; start > 0 {
+		mask &= ^uint64(0) << start
+	}
+	if end := to - w*64; end < 64 {
+		mask &= ^uint64(0) >> (64 - end)
+	}
+	return mask
+}
+
+// countRange counts the set bits in [from, to).
+func countRange(words []uint64, from, to int) int {
+	count := 0
+	for w := from / 64; w*64 < to; w++ {
+		count += bits.OnesCount64(words[w] & rangeMask(w, from, to))
+	}
+	return count
+}
+
+// fillRange sets or clears the bits in [from, to).
+func fillRange(words []uint64, from, to int, value bool) {
+	for w := from / 64; w*64 < to; w++ {
+		if value {
+			words[w] |= rangeMask(w, from, to)
+		} else {
+			words[w] &^= rangeMask(w, from, to)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package day14
+
+import (
+	"fmt"
+	"slices"
+
+	"aoc2023/grid"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(14, solver.NewParsed(parseDish, Dish.northLoad, Dish.spinLoad))
+}
+
+func part1(input string) (int, error) {
+	dish, err := parseDish(input)
+	if err != nil {
+		return 0, err
+	}
+	return dish.northLoad()
+}
+
+func part2(input string) (int, error) {
+	dish, err := parseDish(input)
+	if err != nil {
+		return 0, err
+	}
+	return dish.spinLoad()
+}
+
+// northLoad returns the load after tilting a copy of the dish north.
+func (d Dish) northLoad() (int, error) {
+	tilted := d.clone()
+	tilted.tiltNorth()
+	return tilted.totalLoad(), nil
+}
+
+const spinCycles = 1000000000
+
+// spinLoad returns the load after all spin cycles.
+func (d Dish) spinLoad() (int, error) {
+	final, loop := d.afterSpinCycles(spinCycles)
+	fmt.Fprintf(solver.Debug, "loop of %d spin cycles from cycle %d\n", loop.Length, loop.Start)
+	return final.totalLoad(), nil
+}
+
+// Loop describes the repeating layouts of a dish: after Start spin cycles
+// the layout repeats every Length cycles.
+type Loop struct {
+	Start, Length int
+}
+
+// afterSpinCycles returns the dish after n spin cycles. Since the layouts
+// eventually repeat, it only runs the cycles up to the loop and those into
+// the loop that remain when all full loops are skipped.
+func (d Dish) afterSpinCycles(n int) (Dish, Loop) {
+	board := newBitboard(d)
+	loop := findLoop(newState(board), func(s state) state {
+		next := s.board.clone()
+		next.spinCycle()
+		return newState(next)
+	})
+	if n > loop.Start {
+		n = loop.Start + (n-loop.Start)%loop.Length
+	}
+	result := board.clone()
+	for i := 0; i < n; i++ {
+		result.spinCycle()
+	}
+	return result.dish(), loop
+}
+
+// state is a layout of the round rocks with its hash, which makes telling
+// layouts apart cheap.
+type state struct {
+	board bitboard
+	hash  uint64
+}
+
+func newState(b bitboard) state {
+	return state{b, b.hash()}
+}
+
+func (s state) equal(other state) bool {
+	// equal hashes may still be different layouts
+	return s.hash == other.hash && slices.Equal(s.board.round.words, other.board.round.words)
+}
+
+// findLoop finds the loop in the sequence start, next(start), ... with
+// Brent's algorithm, which keeps only two states at a time: a hare runs
+// ahead of a tortoise that jumps to the hare whenever the distance reaches
+// the next power of two, until the hare meets it, which gives the length.
+// A second hare started that length ahead meets a second tortoise from
+// the start at the beginning of the loop.
+func findLoop(start state, next func(state) state) Loop {
+	power, length := 1, 1
+	tortoise, hare := start, next(start)
+	for !tortoise.equal(hare) {
+		if power == length {
+			tortoise = hare
+			power *= 2
+			length = 0
+		}
+		hare = next(hare)
+		length++
+	}
+
+	tortoise, hare = start, start
+	for i := 0; i < length; i++ {
+		hare = next(hare)
+	}
+	loopStart := 0
+	for !tortoise.equal(hare) {
+		tortoise, hare = next(tortoise), next(hare)
+		loopStart++
+	}
+	return Loop{Start: loopStart, Length: length}
+}
+
+func parseDish(s string) (Dish, error) {
+	positions, err := grid.ParseFunc(s, grid.OneOf("O#."))
+	return Dish{positions: positions}, err
+}
+
+type Dish struct {
+	positions grid.Grid[byte]
+}
+
+func (d Dish) clone() Dish {
+	return Dish{d.positions.Clone()}
+}
+
+func (d Dish) String() string {
+	return d.positions.String()
+}
+
+func (d *Dish) tiltNorth() {
+	farthestNorthRow := make([]int, d.positions.Cols())
+	for i := 0; i < d.positions.Rows(); i++ {
+		row := d.positions.Row(i)
+		for j, position := range row {
+			switch position {
+			case 'O':
+				row[j] = '.'
+				d.positions.Row(farthestNorthRow[j])[j] = 'O'
+				farthestNorthRow[j]++
+			case '#':
+				farthestNorthRow[j] = i + 1
+			}
+		}
+	}
+}
+
+// spinCycle tilts north, west, south and east: rotating the dish clockwise
+// after each tilt brings the next direction to the north.
+func (d *Dish) spinCycle() {
+	for i := 0; i < 4; i++ {
+		d.tiltNorth()
+		d.positions = d.positions.RotateClockwise()
+	}
+}
+
+func (d *Dish) totalLoad() int {
+	sum := 0
+	d.positions.Each(func(p grid.Point, position byte) {
+		if position == 'O' {
+			sum += d.positions.Rows() - p.Row
+		}
+	})
+	return sum
+}
@@ -0,0 +1,93 @@
+package day14
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+
+	"aoc2023/aoctest"
+	"aoc2023/grid"
+)
+
+func TestDay14(t *testing.T) {
+	aoctest.Run(t, 14, aoctest.Parts(part1, part2))
+}
+
+// TestAfterSpinCycles compares skipping loops with running every cycle.
+func TestAfterSpinCycles(t *testing.T) {
+	input, err := os.ReadFile("testdata/example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dish, err := parseDish(strings.TrimRight(string(input), "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, loop := dish.afterSpinCycles(0)
+	if loop != (Loop{Start: 3, Length: 7}) {
+		t.Errorf("got %+v, want loop of 7 from 3", loop)
+	}
+	want := dish.clone()
+	for n := 0; n < 30; n++ {
+		got, _ := dish.afterSpinCycles(n)
+		if got.String() != want.String() {
+			t.Fatalf("after %d cycles got\n%v\nwant\n%v", n, got.String(), want.String())
+		}
+		want.spinCycle()
+	}
+}
+
+// randomDish returns a dish with about a quarter round and a tenth cube rocks.
+func randomDish(rng *rand.Rand, rows, cols int) Dish {
+	positions := grid.New[byte](rows, cols)
+	positions.Each(func(p grid.Point, _ byte) {
+		switch r := rng.Intn(20); {
+		case r < 5:
+			positions.Set(p, 'O')
+		case r < 7:
+			positions.Set(p, '#')
+		default:
+			positions.Set(p, '.')
+		}
+	})
+	return Dish{positions}
+}
+
+func TestBitboard(t *testing.T) {
+	rng := rand.New(rand.NewSource(14))
+	// sizes around the 64-bit word boundaries
+	for _, size := range [][2]int{{1, 1}, {10, 7}, {63, 65}, {130, 129}} {
+		dish := randomDish(rng, size[0], size[1])
+		board := newBitboard(dish)
+		for cycle := 0; cycle < 3; cycle++ {
+			dish.spinCycle()
+			board.spinCycle()
+			if got, want := board.dish().String(), dish.String(); got != want {
+				t.Fatalf("%dx%d, cycle %d: got\n%s\nwant\n%s", size[0], size[1], cycle, got, want)
+			}
+			if got, want := board.totalLoad(), dish.totalLoad(); got != want {
+				t.Fatalf("%dx%d, cycle %d: load %d, want %d", size[0], size[1], cycle, got, want)
+			}
+		}
+	}
+}
+
+func BenchmarkSpinCycle(b *testing.B) {
+	for _, size := range []int{100, 500} {
+		dish := randomDish(rand.New(rand.NewSource(14)), size, size)
+		b.Run(fmt.Sprintf("grid/%d", size), func(b *testing.B) {
+			d := dish.clone()
+			for i := 0; i < b.N; i++ {
+				d.spinCycle()
+			}
+		})
+		b.Run(fmt.Sprintf("bitboard/%d", size), func(b *testing.B) {
+			board := newBitboard(dish)
+			for i := 0; i < b.N; i++ {
+				board.spinCycle()
+			}
+		})
+	}
+}
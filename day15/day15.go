@@ -0,0 +1,125 @@
+package day15
+
+import (
+	"fmt"
+	"io"
+
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(15, solver.NewParsed(parseSequence, sumHashes, focusingPower))
+}
+
+func part1(input string) (int, error) {
+	rawSteps, err := parseSequence(input)
+	if err != nil {
+		return 0, err
+	}
+	return sumHashes(rawSteps)
+}
+
+func part2(input string) (int, error) {
+	rawSteps, err := parseSequence(input)
+	if err != nil {
+		return 0, err
+	}
+	return focusingPower(rawSteps)
+}
+
+func sumHashes(rawSteps []inputs.Span) (int, error) {
+	sum := 0
+	for _, step := range rawSteps {
+		sum += Hash(step.Text)
+	}
+	return sum, nil
+}
+
+func focusingPower(rawSteps []inputs.Span) (int, error) {
+	lenses, err := interpret(rawSteps, nil)
+	if err != nil {
+		return 0, err
+	}
+	return lenses.FocusingPower(func(focalLength int) int { return focalLength }), nil
+}
+
+// Interpret runs the initialization sequence on an empty table of focal
+// lengths by label. If trace is not nil, it writes the boxes after every
+// step to it.
+func Interpret(input string, trace io.Writer) (*Table[int], error) {
+	rawSteps, err := parseSequence(input)
+	if err != nil {
+		return nil, err
+	}
+	return interpret(rawSteps, trace)
+}
+
+func interpret(rawSteps []inputs.Span, trace io.Writer) (*Table[int], error) {
+	steps, err := parseSteps(rawSteps)
+	if err != nil {
+		return nil, err
+	}
+	lenses := &Table[int]{}
+	for _, step := range steps {
+		step.apply(lenses)
+		if trace != nil {
+			fmt.Fprintf(trace, "After \"%v\":\n%v\n", step, lenses)
+		}
+	}
+	return lenses, nil
+}
+
+// Step either removes the lens with the label or inserts a lens.
+type Step struct {
+	label       string
+	remove      bool
+	focalLength int
+}
+
+func (s Step) apply(lenses *Table[int]) {
+	if s.remove {
+		lenses.Remove(s.label)
+	} else {
+		lenses.Put(s.label, s.focalLength)
+	}
+}
+
+func (s Step) String() string {
+	if s.remove {
+		return s.label + "-"
+	}
+	return fmt.Sprintf("%s=%d", s.label, s.focalLength)
+}
+
+// parseSequence splits the initialization sequence into its steps.
+func parseSequence(input string) ([]inputs.Span, error) {
+	lines := inputs.Lines(input)
+	if len(lines) > 1 {
+		return nil, lines[1].Errorf("want a single line, got")
+	}
+	return lines[0].Split(","), nil
+}
+
+func parseSteps(rawSteps []inputs.Span) ([]Step, error) {
+	steps := make([]Step, len(rawSteps))
+	for i, rawStep := range rawSteps {
+		if label, err := rawStep.TrimSuffix("-"); err == nil {
+			steps[i] = Step{label: label.Text, remove: true}
+			continue
+		}
+		label, rawFocalLength, err := rawStep.Cut("=")
+		if err != nil {
+			return nil, rawStep.Errorf("want label- or label=focal length, got")
+		}
+		focalLength, err := rawFocalLength.Int()
+		if err != nil {
+			return nil, err
+		}
+		if focalLength < 1 || focalLength > 9 {
+			return nil, rawFocalLength.Errorf("want focal length 1-9, got")
+		}
+		steps[i] = Step{label: label.Text, focalLength: focalLength}
+	}
+	return steps, nil
+}
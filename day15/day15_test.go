@@ -0,0 +1,55 @@
+package day15
+
+import (
+	"strings"
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay15(t *testing.T) {
+	aoctest.Run(t, 15, aoctest.Parts(part1, part2))
+}
+
+func TestTable(t *testing.T) {
+	table := &Table[string]{}
+	// rn and cm land in box 0, qp in box 1
+	table.Put("rn", "a")
+	table.Put("cm", "b")
+	table.Put("qp", "c")
+	table.Put("rn", "d")
+	if !table.Remove("cm") || table.Remove("cm") {
+		t.Error("Remove(cm) should succeed exactly once")
+	}
+	if value, ok := table.Get("rn"); !ok || value != "d" {
+		t.Errorf("Get(rn) = %q, %v, want d", value, ok)
+	}
+	if got, want := table.String(), "Box 0: [rn d]\nBox 1: [qp c]\n"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	visited := []string{}
+	table.Iterate(func(box, slot int, e Entry[string]) bool {
+		visited = append(visited, e.Key)
+		return false
+	})
+	if len(visited) != 1 || visited[0] != "rn" {
+		t.Errorf("Iterate visited %v after stopping, want [rn]", visited)
+	}
+}
+
+func TestInterpret(t *testing.T) {
+	trace := strings.Builder{}
+	lenses, err := Interpret("rn=1,cm-,qp=3,cm=2", &trace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "After \"rn=1\":\nBox 0: [rn 1]\n\nAfter \"cm-\":\nBox 0: [rn 1]\n\n" +
+		"After \"qp=3\":\nBox 0: [rn 1]\nBox 1: [qp 3]\n\nAfter \"cm=2\":\nBox 0: [rn 1] [cm 2]\nBox 1: [qp 3]\n\n"
+	if trace.String() != want {
+		t.Errorf("got trace\n%s\nwant\n%s", trace.String(), want)
+	}
+	if got := lenses.FocusingPower(func(focalLength int) int { return focalLength }); got != 1*1*1+1*2*2+2*1*3 {
+		t.Errorf("FocusingPower() = %d", got)
+	}
+}
@@ -0,0 +1,105 @@
+package day15
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Hash returns the HASH of s: a value from 0 to 255.
+func Hash(s string) int {
+	currentValue := 0
+	for _, char := range s {
+		currentValue += int(char)
+		currentValue *= 17
+		currentValue %= 256
+	}
+	return currentValue
+}
+
+// Table is the HASHMAP of the puzzle: a hash table of 256 boxes, each
+// keeping its entries in the order they were first put.
+type Table[V any] struct {
+	boxes [256][]Entry[V]
+}
+
+type Entry[V any] struct {
+	Key   string
+	Value V
+}
+
+// Put replaces the value of the key where it is, or adds it to the back of
+// its box.
+func (t *Table[V]) Put(key string, value V) {
+	box := &t.boxes[Hash(key)]
+	if i := t.index(*box, key); i >= 0 {
+		(*box)[i].Value = value
+		return
+	}
+	*box = append(*box, Entry[V]{key, value})
+}
+
+// Remove removes the key, moving the entries behind it forward, and
+// reports whether it was there.
+func (t *Table[V]) Remove(key string) bool {
+	box := &t.boxes[Hash(key)]
+	i := t.index(*box, key)
+	if i < 0 {
+		return false
+	}
+	*box = slices.Delete(*box, i, i+1)
+	return true
+}
+
+// Get returns the value of the key.
+func (t *Table[V]) Get(key string) (V, bool) {
+	box := t.boxes[Hash(key)]
+	if i := t.index(box, key); i >= 0 {
+		return box[i].Value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (t *Table[V]) index(box []Entry[V], key string) int {
+	return slices.IndexFunc(box, func(e Entry[V]) bool { return e.Key == key })
+}
+
+// Iterate calls f for every entry by box and then slot, where slots count
+// from 1 like in the puzzle, until f returns false.
+func (t *Table[V]) Iterate(f func(box, slot int, e Entry[V]) bool) {
+	for box, entries := range t.boxes {
+		for i, e := range entries {
+			if !f(box, i+1, e) {
+				return
+			}
+		}
+	}
+}
+
+// FocusingPower sums, over all entries, one plus the box number times the
+// slot times the focal length of the value.
+func (t *Table[V]) FocusingPower(focalLength func(V) int) int {
+	sum := 0
+	t.Iterate(func(box, slot int, e Entry[V]) bool {
+		sum += (1 + box) * slot * focalLength(e.Value)
+		return true
+	})
+	return sum
+}
+
+// String lists the non-empty boxes like the puzzle, e.g. "Box 0: [rn 1] [cm 2]".
+func (t *Table[V]) String() string {
+	builder := strings.Builder{}
+	for box, entries := range t.boxes {
+		if len(entries) == 0 {
+			continue
+		}
+		fmt.Fprintf(&builder, "Box %d:", box)
+		for _, e := range entries {
+			fmt.Fprintf(&builder, " [%s %v]", e.Key, e.Value)
+		}
+		builder.WriteByte('\n')
+	}
+	return builder.String()
+}
@@ -0,0 +1,140 @@
+package day16
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+
+	"aoc2023/grid"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(16, solver.NewParsed(parseContraption, Contraption.topLeftEnergized, Contraption.maxEnergized))
+}
+
+func part1(input string) (int, error) {
+	contraption, err := parseContraption(input)
+	if err != nil {
+		return 0, err
+	}
+	return contraption.topLeftEnergized()
+}
+
+func part2(input string) (int, error) {
+	contraption, err := parseContraption(input)
+	if err != nil {
+		return 0, err
+	}
+	return contraption.maxEnergized()
+}
+
+func parseContraption(input string) (Contraption, error) {
+	tiles, err := grid.ParseFunc(input, grid.OneOf(`./\|-`))
+	return Contraption{tiles}, err
+}
+
+func (contraption Contraption) topLeftEnergized() (int, error) {
+	return contraption.energizedCount(grid.Point{Row: 0, Col: 0}, grid.Right), nil
+}
+
+// maxEnergized traces the beams from all edge tiles into the contraption
+// on a pool of workers and returns the most tiles energized.
+func (contraption Contraption) maxEnergized() (int, error) {
+	starts := []beam{}
+	lastRow := contraption.tiles.Rows() - 1
+	lastCol := contraption.tiles.Cols() - 1
+	for col := 0; col <= lastCol; col++ {
+		starts = append(starts,
+			beam{grid.Point{Row: 0, Col: col}, grid.Down},
+			beam{grid.Point{Row: lastRow, Col: col}, grid.Up})
+	}
+	for row := 0; row <= lastRow; row++ {
+		starts = append(starts,
+			beam{grid.Point{Row: row, Col: 0}, grid.Right},
+			beam{grid.Point{Row: row, Col: lastCol}, grid.Left})
+	}
+
+	counts := make([]int, len(starts))
+	indices := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < runtime.GOMAXPROCS(0); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				counts[i] = contraption.energizedCount(starts[i].pos, starts[i].dir)
+			}
+		}()
+	}
+	for i := range starts {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	return slices.Max(counts), nil
+}
+
+type Contraption struct {
+	tiles grid.Grid[byte]
+}
+
+// beam is a beam of light entering the tile at pos in direction dir.
+type beam struct {
+	pos grid.Point
+	dir grid.Dir
+}
+
+// energizedCount traces the beam with an explicit stack of the beams still
+// to follow. A beam entering a tile in a direction it entered before would
+// only retrace its path, so every tile remembers the directions it was
+// entered in, and every beam is followed at most once.
+func (c Contraption) energizedCount(startingPos grid.Point, direction grid.Dir) int {
+	// the bits of grid.Dirs4 indices that entered each tile
+	entered := grid.New[uint8](c.tiles.Rows(), c.tiles.Cols())
+	count := 0
+	stack := []beam{{startingPos, direction}}
+	for len(stack) > 0 {
+		b := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !c.tiles.In(b.pos) {
+			continue
+		}
+		mask := uint8(1) << slices.Index(grid.Dirs4, b.dir)
+		seen := entered.At(b.pos)
+		if seen&mask != 0 {
+			continue
+		}
+		if seen == 0 {
+			count++
+		}
+		entered.Set(b.pos, seen|mask)
+		for _, d := range c.deflect(b.pos, b.dir) {
+			stack = append(stack, beam{b.pos.Move(d), d})
+		}
+	}
+	return count
+}
+
+// deflect returns the directions a beam leaves the tile at pos in when it
+// enters it in direction d.
+func (c Contraption) deflect(pos grid.Point, d grid.Dir) []grid.Dir {
+	switch c.tiles.At(pos) {
+	case '-':
+		if d.IsVertical() {
+			return []grid.Dir{grid.Left, grid.Right}
+		}
+	case '|':
+		if d.IsHorizontal() {
+			return []grid.Dir{grid.Up, grid.Down}
+		}
+	case '\\':
+		// right turns to down, up turns to left
+		return []grid.Dir{{Row: d.Col, Col: d.Row}}
+	case '/':
+		// right turns to up, up turns to right
+		return []grid.Dir{{Row: -d.Col, Col: -d.Row}}
+	}
+	return []grid.Dir{d}
+}
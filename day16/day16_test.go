@@ -0,0 +1,41 @@
+package day16
+
+import (
+	"strings"
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay16(t *testing.T) {
+	aoctest.Run(t, 16, aoctest.Parts(part1, part2))
+}
+
+// TestSerpentine sends the beam through every tile of a large contraption,
+// row by row, which takes as many steps as there are tiles.
+func TestSerpentine(t *testing.T) {
+	const size = 300
+	lines := make([]string, size)
+	for row := range lines {
+		line := []byte(strings.Repeat(".", size))
+		if row%2 == 0 {
+			line[size-1] = '\\'
+			if row > 0 {
+				line[0] = '\\'
+			}
+		} else {
+			line[0], line[size-1] = '/', '/'
+		}
+		lines[row] = string(line)
+	}
+	contraption, err := parseContraption(strings.Join(lines, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := contraption.topLeftEnergized(); got != size*size {
+		t.Errorf("got %d energized tiles, want %d", got, size*size)
+	}
+	if got, _ := contraption.maxEnergized(); got != size*size {
+		t.Errorf("got at most %d energized tiles, want %d", got, size*size)
+	}
+}
@@ -0,0 +1,188 @@
+package day17
+
+import (
+	"errors"
+	"math"
+	"slices"
+
+	"aoc2023/grid"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(17, solver.NewParsed(parseHeatLoss, minHeatLoss, minUltraHeatLoss))
+}
+
+func part1(input string) (int, error) {
+	heatLoss, err := parseHeatLoss(input)
+	if err != nil {
+		return 0, err
+	}
+	return minHeatLoss(heatLoss)
+}
+
+func part2(input string) (int, error) {
+	heatLoss, err := parseHeatLoss(input)
+	if err != nil {
+		return 0, err
+	}
+	return minUltraHeatLoss(heatLoss)
+}
+
+func minHeatLoss(heatLoss grid.Grid[int]) (int, error) {
+	start := Node{grid.Point{Row: 0, Col: 0}, grid.Right, 0}
+	target := grid.Point{Row: heatLoss.Rows() - 1, Col: heatLoss.Cols() - 1}
+	result, found := aStar(start, func(n Node) bool {
+		return n.pos == target
+	}, func(n Node) []Node {
+		return n.successors(heatLoss)
+	}, func(n Node) int {
+		return heatLoss.At(n.pos)
+	})
+	if !found {
+		return 0, errors.New("no path to the machine parts factory")
+	}
+	return result, nil
+}
+
+func minUltraHeatLoss(heatLoss grid.Grid[int]) (int, error) {
+	start := Node{grid.Point{Row: 0, Col: 0}, grid.Right, 0}
+	target := grid.Point{Row: heatLoss.Rows() - 1, Col: heatLoss.Cols() - 1}
+	result, found := aStar(start, func(n Node) bool {
+		return n.pos == target && n.straightCount >= 4
+	}, func(n Node) []Node {
+		return n.ultraSuccessors(heatLoss)
+	}, func(n Node) int {
+		return heatLoss.At(n.pos)
+	})
+	if !found {
+		return 0, errors.New("no path to the machine parts factory")
+	}
+	return result, nil
+}
+
+type successorsFunc func(n Node) []Node
+
+type weigthFunc func(n Node) int
+
+type isTargetFunc func(n Node) bool
+
+func aStar(start Node, isTarget isTargetFunc, successors successorsFunc, weight weigthFunc) (result int, found bool) {
+	g := make(map[Node]int)
+	g[start] = 0
+
+	f := func(n Node) int {
+		if curG, ok := g[n]; ok {
+			return curG
+		}
+		return math.MaxInt
+	}
+
+	openList := []Node{start}
+
+	closedList := make(map[Node]bool, 0)
+
+	for len(openList) != 0 {
+		slices.SortFunc(openList, func(a Node, b Node) int {
+			return f(a) - f(b)
+		})
+		currentNode := openList[0]
+		openList = openList[1:]
+		if isTarget(currentNode) {
+			return g[currentNode], true
+		}
+		closedList[currentNode] = true
+
+		for _, successor := range successors(currentNode) {
+			if _, ok := closedList[successor]; ok {
+				continue
+			}
+			tentativeG := g[currentNode] + weight(successor)
+			if curG, ok := g[successor]; !ok || tentativeG < curG {
+				g[successor] = tentativeG
+			}
+			if !slices.Contains(openList, successor) {
+				openList = append(openList, successor)
+			}
+		}
+	}
+	return -1, false
+}
+
+type Node struct {
+	pos           grid.Point
+	direction     grid.Dir
+	straightCount int
+}
+
+func (n Node) ultraSuccessors(heatLoss grid.Grid[int]) []Node {
+	successors := make([]Node, 0)
+	if n.straightCount < 10 {
+		straightNode := Node{
+			pos:           n.pos.Move(n.direction),
+			direction:     n.direction,
+			straightCount: n.straightCount + 1,
+		}
+		if heatLoss.In(straightNode.pos) {
+			successors = append(successors, straightNode)
+		}
+	}
+	if n.straightCount >= 4 || n.straightCount == 0 {
+		left := n.direction.TurnLeft()
+		leftNode := Node{
+			pos:           n.pos.Move(left),
+			direction:     left,
+			straightCount: 1,
+		}
+		if heatLoss.In(leftNode.pos) {
+			successors = append(successors, leftNode)
+		}
+		right := n.direction.TurnRight()
+		rightNode := Node{
+			pos:           n.pos.Move(right),
+			direction:     right,
+			straightCount: 1,
+		}
+		if heatLoss.In(rightNode.pos) {
+			successors = append(successors, rightNode)
+		}
+	}
+	return successors
+}
+
+func (n Node) successors(heatLoss grid.Grid[int]) []Node {
+	successors := make([]Node, 0)
+	if n.straightCount < 3 {
+		straightNode := Node{
+			pos:           n.pos.Move(n.direction),
+			direction:     n.direction,
+			straightCount: n.straightCount + 1,
+		}
+		if heatLoss.In(straightNode.pos) {
+			successors = append(successors, straightNode)
+		}
+	}
+	left := n.direction.TurnLeft()
+	leftNode := Node{
+		pos:           n.pos.Move(left),
+		direction:     left,
+		straightCount: 1,
+	}
+	if heatLoss.In(leftNode.pos) {
+		successors = append(successors, leftNode)
+	}
+	right := n.direction.TurnRight()
+	rightNode := Node{
+		pos:           n.pos.Move(right),
+		direction:     right,
+		straightCount: 1,
+	}
+	if heatLoss.In(rightNode.pos) {
+		successors = append(successors, rightNode)
+	}
+	return successors
+}
+
+func parseHeatLoss(s string) (grid.Grid[int], error) {
+	return grid.ParseFunc(s, grid.Digits)
+}
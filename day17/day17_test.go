@@ -0,0 +1,11 @@
+package day17
+
+import (
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay17(t *testing.T) {
+	aoctest.Run(t, 17, aoctest.Parts(part1, part2))
+}
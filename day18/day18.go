@@ -0,0 +1,176 @@
+package day18
+
+import (
+	"strconv"
+	"strings"
+
+	"aoc2023/grid"
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(18, solver.NewParsed(parsePlans, Plans.area, Plans.colorArea))
+}
+
+func part1(input string) (int, error) {
+	plans, err := parsePlans(input)
+	if err != nil {
+		return 0, err
+	}
+	return plans.area()
+}
+
+func part2(input string) (int, error) {
+	plans, err := parsePlans(input)
+	if err != nil {
+		return 0, err
+	}
+	return plans.colorArea()
+}
+
+// Plans holds both readings of the dig plan: the instructions of part 1
+// and the ones hidden in the color codes.
+type Plans struct {
+	plan, colorPlan DigPlan
+}
+
+func parsePlans(input string) (Plans, error) {
+	plan, err := parseDigPlan(input, parseInstruction)
+	if err != nil {
+		return Plans{}, err
+	}
+	colorPlan, err := parseDigPlan(input, parseInstruction2)
+	if err != nil {
+		return Plans{}, err
+	}
+	return Plans{plan, colorPlan}, nil
+}
+
+func (p Plans) area() (int, error) {
+	return p.plan.lagoonArea(), nil
+}
+
+func (p Plans) colorArea() (int, error) {
+	return p.colorPlan.lagoonArea(), nil
+}
+
+// parseDigPlan reads the lines of the plan with the given instruction parser.
+func parseDigPlan(s string, parseInstruction func(fields []inputs.Span) (Instruction, error)) (DigPlan, error) {
+	lines := inputs.Lines(s)
+	instructions := make([]Instruction, len(lines))
+	for i, line := range lines {
+		fields := line.Fields()
+		if len(fields) != 3 {
+			return DigPlan{}, line.Errorf("want direction, distance and color, got")
+		}
+		var err error
+		instructions[i], err = parseInstruction(fields)
+		if err != nil {
+			return DigPlan{}, err
+		}
+	}
+	return DigPlan{instructions}, nil
+}
+
+func parseInstruction(fields []inputs.Span) (Instruction, error) {
+	direction, ok := map[string]grid.Dir{
+		"R": grid.Right,
+		"L": grid.Left,
+		"U": grid.Up,
+		"D": grid.Down,
+	}[fields[0].Text]
+	if !ok {
+		return Instruction{}, fields[0].Errorf("want direction R, L, U or D, got")
+	}
+	distance, err := fields[1].Int()
+	if err != nil {
+		return Instruction{}, err
+	}
+	return Instruction{
+		direction: direction,
+		distance:  distance,
+	}, nil
+}
+
+// parseInstruction2 reads the instruction hidden in the color code: five hex
+// digits of distance and the direction 0 (R), 1 (D), 2 (L) or 3 (U).
+func parseInstruction2(fields []inputs.Span) (Instruction, error) {
+	rawInstruction, err := fields[2].TrimPrefix("(#")
+	if err != nil {
+		return Instruction{}, err
+	}
+	rawInstruction, err = rawInstruction.TrimSuffix(")")
+	if err != nil {
+		return Instruction{}, err
+	}
+	if len(rawInstruction.Text) != 6 {
+		return Instruction{}, rawInstruction.Errorf("want 6 hex digits, got")
+	}
+	rawDistance := rawInstruction.Slice(0, 5)
+	distance, err := strconv.ParseInt(rawDistance.Text, 16, 0)
+	if err != nil {
+		return Instruction{}, rawDistance.Errorf("invalid hex distance")
+	}
+	rawDirection := rawInstruction.Slice(5, 6)
+	directionIndex := strings.Index("0123", rawDirection.Text)
+	if directionIndex < 0 {
+		return Instruction{}, rawDirection.Errorf("want direction 0-3, got")
+	}
+	return Instruction{
+		direction: []grid.Dir{grid.Right, grid.Down, grid.Left, grid.Up}[directionIndex],
+		distance:  int(distance),
+	}, nil
+}
+
+type DigPlan struct {
+	instructions []Instruction
+}
+
+func (d DigPlan) lagoonArea() int {
+	area := 0
+	curPos := grid.Point{Row: 0, Col: 0}
+	for i, instruction := range d.instructions {
+		previousDirection := d.instructions[(i-1+len(d.instructions))%len(d.instructions)].direction
+		currentDirection := instruction.direction
+		nextDirection := d.instructions[(i+1)%len(d.instructions)].direction
+		distance := instruction.distance
+		// if edge (#) is like this (two right turns)
+		// >########
+		//  inside # outside
+		// <########
+		// then edge is actually 1 longer then distance (so each # is accounted for in area calculation)
+		if isRightTurn(previousDirection, currentDirection) && isRightTurn(currentDirection, nextDirection) {
+			distance++
+		}
+
+		// check if edge (#) is like this (two left turns)
+		//         ########<
+		//  inside # outside
+		//         ########>
+		// then edge is actually 1 shorter then distance because tiles outside are 1 less then distance)
+		if isLeftTurn(previousDirection, currentDirection) && isLeftTurn(currentDirection, nextDirection) {
+			distance--
+		}
+		nextPos := curPos.MoveN(instruction.direction, distance)
+		area += ((curPos.Row + nextPos.Row) * (curPos.Col - nextPos.Col))
+		curPos = nextPos
+	}
+	if area < 0 {
+		return (-area / 2)
+	}
+	return (area / 2)
+}
+
+type Instruction struct {
+	direction grid.Dir
+	distance  int
+}
+
+func isRightTurn(direction, nextDirection grid.Dir) bool {
+	return direction.TurnRight() == nextDirection
+}
+
+func isLeftTurn(direction, nextDirection grid.Dir) bool {
+	return direction.TurnLeft() == nextDirection
+}
@@ -0,0 +1,11 @@
+package day18
+
+import (
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay18(t *testing.T) {
+	aoctest.Run(t, 18, aoctest.Parts(part1, part2))
+}
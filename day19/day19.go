@@ -0,0 +1,357 @@
+package day19
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(19, solver.NewParsed(parseSystem, System.sumAccepted, System.countAccepted))
+}
+
+func part1(input string) (int, error) {
+	system, err := parseSystem(input)
+	if err != nil {
+		return 0, err
+	}
+	return system.sumAccepted()
+}
+
+func part2(input string) (int, error) {
+	system, err := parseSystem(input)
+	if err != nil {
+		return 0, err
+	}
+	return system.countAccepted()
+}
+
+// System is the input: the workflows and the parts to sort.
+type System struct {
+	workflows Workflows
+	parts     []Part
+}
+
+// sumAccepted sums the ratings of all accepted parts.
+func (s System) sumAccepted() (int, error) {
+	sum := 0
+	for _, part := range s.parts {
+		if s.workflows.get("in").accepts(part) {
+			sum += part.sumRatings()
+		}
+	}
+	return sum, nil
+}
+
+// countAccepted counts the combinations of ratings from 1 to 4000 that
+// would be accepted.
+func (s System) countAccepted() (int, error) {
+	maxValue := 4000
+	combinationsCount := s.workflows.get("in").countCombinations("A", s.workflows, CategoryRatings{
+		IntRange{1, maxValue + 1},
+		IntRange{1, maxValue + 1},
+		IntRange{1, maxValue + 1},
+		IntRange{1, maxValue + 1},
+	})
+	return combinationsCount, nil
+}
+
+// parseSystem reads the workflows and the parts, which follow after an empty line.
+func parseSystem(input string) (System, error) {
+	blocks := inputs.Blocks(input)
+	if len(blocks) != 2 {
+		return System{}, fmt.Errorf("want workflows and parts separated by an empty line, got %d blocks", len(blocks))
+	}
+	workflows, err := parseWorkflows(blocks[0])
+	if err != nil {
+		return System{}, err
+	}
+	parts := make([]Part, len(blocks[1]))
+	for i, line := range blocks[1] {
+		parts[i], err = parsePart(line)
+		if err != nil {
+			return System{}, err
+		}
+	}
+	return System{workflows, parts}, nil
+}
+
+func (this Workflow) accepts(part Part) bool {
+	if this.name == "A" {
+		return true
+	}
+	if this.name == "R" {
+		return false
+	}
+	for _, rule := range this.rules {
+		if rule.accepts(part) {
+			return rule.next.accepts(part)
+		}
+	}
+	return false
+}
+
+func (this Rule) accepts(part Part) bool {
+	i := strings.Index("xmas", this.category)
+	switch this.op {
+	case ">":
+		return part.ratings[i] > this.value
+	case "<":
+		return part.ratings[i] < this.value
+	default:
+		return true
+	}
+}
+
+type Part struct {
+	ratings []int
+}
+
+func parsePart(s inputs.Span) (Part, error) {
+	s, err := s.TrimPrefix("{")
+	if err != nil {
+		return Part{}, err
+	}
+	s, err = s.TrimSuffix("}")
+	if err != nil {
+		return Part{}, err
+	}
+	rawRatings := s.Split(",")
+	if len(rawRatings) != 4 {
+		return Part{}, s.Errorf("want 4 ratings, got")
+	}
+	ratings := make([]int, len(rawRatings))
+	for i := range rawRatings {
+		category, rawRating, err := rawRatings[i].Cut("=")
+		if err != nil {
+			return Part{}, err
+		}
+		if category.Text != "xmas"[i:i+1] {
+			return Part{}, category.Errorf("want category %q, got", "xmas"[i:i+1])
+		}
+		ratings[i], err = rawRating.Int()
+		if err != nil {
+			return Part{}, err
+		}
+	}
+	return Part{ratings}, nil
+}
+
+func (p Part) sumRatings() int {
+	sum := 0
+	for i := range p.ratings {
+		sum += p.ratings[i]
+	}
+	return sum
+}
+
+func parseWorkflows(lines []inputs.Span) (Workflows, error) {
+	workflows := make(map[string]*Workflow)
+	// the first reference to every workflow, to report the undefined ones
+	references := []inputs.Span{}
+	defined := map[string]bool{"A": true, "R": true}
+	for _, line := range lines {
+		name, err := parseWorkflow(line, workflows, &references)
+		if err != nil {
+			return nil, err
+		}
+		if defined[name.Text] {
+			return nil, name.Errorf("workflow defined twice")
+		}
+		defined[name.Text] = true
+	}
+	if !defined["in"] {
+		return nil, errors.New("no workflow named in")
+	}
+	for _, reference := range references {
+		if !defined[reference.Text] {
+			return nil, reference.Errorf("undefined workflow")
+		}
+	}
+	return workflows, nil
+}
+
+var workflowPattern = regexp.MustCompile(`^([a-z]+)\{(.*)\}$`)
+
+func parseWorkflow(s inputs.Span, workflows Workflows, references *[]inputs.Span) (inputs.Span, error) {
+	matches := workflowPattern.FindStringSubmatchIndex(s.Text)
+	if matches == nil {
+		return s, s.Errorf("want workflow like \"px{a<2006:qkq,rfg}\", got")
+	}
+	name := s.Slice(matches[2], matches[3])
+	rawRules := s.Slice(matches[4], matches[5]).Split(",")
+	rules := make([]Rule, len(rawRules))
+	for i := range rawRules {
+		var err error
+		rules[i], err = parseRule(rawRules[i], workflows, references)
+		if err != nil {
+			return name, err
+		}
+		if rules[i].op == "" && i != len(rawRules)-1 {
+			return name, rawRules[i].Errorf("rule without condition before the last rule")
+		}
+	}
+	if rules[len(rules)-1].op != "" {
+		return name, rawRules[len(rules)-1].Errorf("last rule has a condition")
+	}
+	workflows.update(name.Text, Workflow{name.Text, rules})
+	return name, nil
+}
+
+var rulePattern = regexp.MustCompile(`^([xmas])([<>])([0-9]+):([a-zA-Z]+)$`)
+
+func parseRule(s inputs.Span, workflows Workflows, references *[]inputs.Span) (Rule, error) {
+	if !strings.Contains(s.Text, ":") {
+		if err := checkWorkflowName(s); err != nil {
+			return Rule{}, err
+		}
+		*references = append(*references, s)
+		return Rule{
+			Condition: Condition{
+				category: "",
+				op:       "",
+				value:    -1,
+			},
+			next: workflows.get(s.Text),
+		}, nil
+	}
+
+	submatches := rulePattern.FindStringSubmatchIndex(s.Text)
+	if submatches == nil {
+		return Rule{}, s.Errorf("want rule like \"a<2006:qkq\", got")
+	}
+	category := s.Slice(submatches[2], submatches[3]).Text
+	operation := s.Slice(submatches[4], submatches[5]).Text
+	value, err := s.Slice(submatches[6], submatches[7]).Int()
+	if err != nil {
+		return Rule{}, err
+	}
+	nextWorkflow := s.Slice(submatches[8], submatches[9])
+	*references = append(*references, nextWorkflow)
+
+	return Rule{
+		Condition: Condition{
+			category: category,
+			op:       operation,
+			value:    value,
+		},
+		next: workflows.get(nextWorkflow.Text),
+	}, nil
+}
+
+func checkWorkflowName(s inputs.Span) error {
+	if s.Text == "A" || s.Text == "R" {
+		return nil
+	}
+	if s.Text == "" || strings.IndexFunc(s.Text, func(r rune) bool { return r < 'a' || r > 'z' }) >= 0 {
+		return s.Errorf("invalid workflow name")
+	}
+	return nil
+}
+
+type Workflows map[string]*Workflow
+
+func (this Workflows) get(name string) *Workflow {
+	if _, ok := this[name]; !ok {
+		this[name] = &Workflow{
+			name: name,
+		}
+	}
+	return this[name]
+}
+
+func (this Workflows) update(name string, workflow Workflow) {
+	if _, ok := this[name]; !ok {
+		this[name] = &Workflow{
+			name: name,
+		}
+	}
+	this[name].name = workflow.name
+	this[name].rules = workflow.rules
+}
+
+type Workflow struct {
+	name  string
+	rules []Rule
+}
+
+func (this Workflow) countCombinations(target string, workflows Workflows, acceptsRatings CategoryRatings) int {
+	result := 0
+	prevCondition := Condition{}
+	for _, rule := range this.rules {
+		acceptsRatings = acceptsRatings.restrictReverse(prevCondition)
+		currentRuleAcceptRanges := acceptsRatings.restrict(rule.Condition)
+		if rule.next.name == target {
+			result += currentRuleAcceptRanges.combinations()
+		} else {
+			result += rule.next.countCombinations(target, workflows, currentRuleAcceptRanges)
+		}
+		prevCondition = rule.Condition
+	}
+	return result
+}
+
+type CategoryRatings []IntRange
+
+func (this CategoryRatings) String() string {
+	builder := strings.Builder{}
+	for _, r := range this {
+		builder.WriteString(fmt.Sprintf("[%d,%d)", r.start, r.end))
+	}
+	return builder.String()
+}
+
+func (this CategoryRatings) combinations() int {
+	result := 1
+	for i := range this {
+		result *= this[i].Size()
+	}
+	return result
+}
+
+func (this CategoryRatings) restrict(c Condition) CategoryRatings {
+	newRatings := make(CategoryRatings, len(this))
+	copy(newRatings, this)
+	categoryIndex := strings.Index("xmas", c.category)
+	switch c.op {
+	case ">":
+		newRatings[categoryIndex].start = c.value + 1
+	case "<":
+		newRatings[categoryIndex].end = c.value
+	}
+	return newRatings
+}
+
+func (this CategoryRatings) restrictReverse(c Condition) CategoryRatings {
+	newRatings := make(CategoryRatings, len(this))
+	copy(newRatings, this)
+	categoryIndex := strings.Index("xmas", c.category)
+	switch c.op {
+	case ">":
+		newRatings[categoryIndex].end = c.value + 1
+	case "<":
+		newRatings[categoryIndex].start = c.value
+	}
+	return newRatings
+}
+
+type Rule struct {
+	Condition
+	next *Workflow
+}
+
+type Condition struct {
+	op       string
+	value    int
+	category string
+}
+
+type IntRange struct {
+	start, end int
+}
+
+func (this IntRange) Size() int { return this.end - this.start }
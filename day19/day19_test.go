@@ -0,0 +1,11 @@
+package day19
+
+import (
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay19(t *testing.T) {
+	aoctest.Run(t, 19, aoctest.Parts(part1, part2))
+}
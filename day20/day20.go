@@ -1,34 +1,40 @@
-package main
+package day20
 
 import (
-	_ "embed"
-	"log"
-	"regexp"
+	"errors"
+	"slices"
 	"strings"
 
 	"golang.org/x/exp/maps"
-)
 
-//go:embed example1.txt
-var example1 string
+	"aoc2023/inputs"
+	"aoc2023/numth"
+	"aoc2023/solver"
+)
 
-//go:embed example2.txt
-var example2 string
+func init() {
+	solver.Register(20, solver.NewParsed(parseModules, pulseProduct, buttonPressesToRx))
+}
 
-//go:embed input.txt
-var input string
+func part1(input string) (int, error) {
+	modules, err := parseModules(input)
+	if err != nil {
+		return 0, err
+	}
+	return pulseProduct(modules)
+}
 
-func main() {
-	exampleResult1 := part1(example1)
-	if exampleResult1 != 32000000 {
-		log.Fatalf("Part 1 wrong; acutal: %d\n", exampleResult1)
+func part2(input string) (int, error) {
+	modules, err := parseModules(input)
+	if err != nil {
+		return 0, err
 	}
-	log.Printf("Part 1: %d\n", part1(input))
-	log.Printf("Part 2: %d\n", part2(input))
+	return buttonPressesToRx(modules)
 }
 
-func part1(input string) int {
-	modules := parseModules(input)
+// pulseProduct multiplies the low and high pulses sent in 1000 button presses.
+func pulseProduct(parsed map[string]Module) (int, error) {
+	modules := cloneModules(parsed)
 	for i := 0; i < 1000; i++ {
 		pushButton(modules)
 	}
@@ -39,17 +45,18 @@ func part1(input string) int {
 		lowPulseCount += module.LowPulseCount()
 		highPulseCount += module.HighPulseCount()
 	}
-	return lowPulseCount * highPulseCount
+	return lowPulseCount * highPulseCount, nil
 }
 
 // module which ouputs rx is &
 // 1. check each of its inputs individually how many button presses it takes for a high pulse
 // 2. take least common multiple of those counts (high pulses occur at fixed intervals)
-func part2(input string) int {
-	modules := parseModules(input)
-	re := regexp.MustCompile("(..) -> rx")
-	targetModuleName := re.FindStringSubmatch(input)[1]
-	targetModule := modules[targetModuleName].(*ConjunctionModule)
+func buttonPressesToRx(parsed map[string]Module) (int, error) {
+	modules := cloneModules(parsed)
+	targetModule, ok := senderToRx(modules)
+	if !ok {
+		return 0, errors.New("no conjunction module sends only to rx")
+	}
 
 	targetModuleInputs := maps.Keys(targetModule.currentInputs)
 
@@ -68,7 +75,29 @@ func part2(input string) int {
 		}
 	}
 
-	return lcm(maps.Values(counts)...)
+	return numth.LCM(maps.Values(counts)...)
+}
+
+// senderToRx returns the conjunction module that sends only to rx.
+func senderToRx(modules map[string]Module) (*ConjunctionModule, bool) {
+	names := maps.Keys(modules)
+	slices.Sort(names)
+	for _, name := range names {
+		if module, ok := modules[name].(*ConjunctionModule); ok && slices.Equal(module.destinations, []string{"rx"}) {
+			return module, true
+		}
+	}
+	return nil, false
+}
+
+// cloneModules returns a copy of the modules, so their state can change
+// without touching the parsed ones.
+func cloneModules(modules map[string]Module) map[string]Module {
+	clone := make(map[string]Module, len(modules))
+	for name, module := range modules {
+		clone[name] = module.Clone()
+	}
+	return clone
 }
 
 func pushButton(modules map[string]Module) {
@@ -84,33 +113,51 @@ func pushButton(modules map[string]Module) {
 	}
 }
 
-func parseModules(s string) map[string]Module {
-	rawModules := strings.Split(s, "\n")
+func parseModules(s string) (map[string]Module, error) {
 	modules := make(map[string]Module)
 	moduleInputs := make(map[string][]string)
 
-	for _, rawModule := range rawModules {
-		module := parseModule(rawModule, moduleInputs)
+	for _, line := range inputs.Lines(s) {
+		module, err := parseModule(line, moduleInputs)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := modules[module.Name()]; ok {
+			return nil, line.Errorf("module %s defined twice in", module.Name())
+		}
 		modules[module.Name()] = module
 	}
+	if _, ok := modules["broadcaster"]; !ok {
+		return nil, errors.New("no broadcaster module")
+	}
 
-	for name, inputs := range moduleInputs {
+	for name, sources := range moduleInputs {
 		if module, ok := modules[name]; ok {
-			module.WireInputs(inputs)
+			module.WireInputs(sources)
 		}
 	}
 
-	return modules
+	return modules, nil
 }
 
-func parseModule(s string, moduleInputs map[string][]string) Module {
-	moduleName := strings.Split(strings.ReplaceAll(s, " ", ""), "->")[0]
-	destinations := strings.Split(strings.Split(strings.ReplaceAll(s, " ", ""), "->")[1], ",")
-	moduleType := moduleName[0]
+func parseModule(s inputs.Span, moduleInputs map[string][]string) (Module, error) {
+	rawName, rawDestinations, err := s.Cut(" -> ")
+	if err != nil {
+		return nil, err
+	}
+	destinations := make([]string, 0)
+	for _, destination := range rawDestinations.Split(",") {
+		destination = destination.TrimSpace()
+		if destination.Text == "" {
+			return nil, destination.MissingErrorf("missing destination")
+		}
+		destinations = append(destinations, destination.Text)
+	}
+	moduleName := rawName.Text
 
 	var result Module
-	switch moduleType {
-	case '%':
+	switch {
+	case strings.HasPrefix(moduleName, "%"):
 		moduleName = moduleName[1:]
 		result = &FlipFlopModule{
 			name:          moduleName,
@@ -118,19 +165,24 @@ func parseModule(s string, moduleInputs map[string][]string) Module {
 			currentInputs: []bool{},
 			destinations:  destinations,
 		}
-	case '&':
+	case strings.HasPrefix(moduleName, "&"):
 		moduleName = moduleName[1:]
 		result = &ConjunctionModule{
 			name:          moduleName,
 			currentInputs: make(map[string]bool),
 			destinations:  destinations,
 		}
-	default:
+	case moduleName == "broadcaster":
 		result = &BroadcasterModule{
 			name:         moduleName,
 			currentInput: false,
 			destinations: destinations,
 		}
+	default:
+		return nil, rawName.Errorf("want %%flip-flop, &conjunction or broadcaster, got")
+	}
+	if moduleName == "" {
+		return nil, rawName.Errorf("missing module name in")
 	}
 
 	for _, dest := range destinations {
@@ -140,7 +192,7 @@ func parseModule(s string, moduleInputs map[string][]string) Module {
 		moduleInputs[dest] = append(moduleInputs[dest], moduleName)
 	}
 
-	return result
+	return result, nil
 }
 
 type Module interface {
@@ -148,6 +200,7 @@ type Module interface {
 	SendPulse(from string, pulse bool)
 	ProcessPulse(modules map[string]Module) []string
 	WireInputs(inputs []string)
+	Clone() Module
 	LowPulseCount() int
 	HighPulseCount() int
 }
@@ -177,6 +230,10 @@ func (b *BroadcasterModule) ProcessPulse(modules map[string]Module) []string {
 	}
 	return b.destinations
 }
+func (b *BroadcasterModule) Clone() Module {
+	clone := *b
+	return &clone
+}
 func (b *BroadcasterModule) LowPulseCount() int  { return b.lowPulseCount }
 func (b *BroadcasterModule) HighPulseCount() int { return b.highPulseCount }
 
@@ -214,6 +271,11 @@ func (b *FlipFlopModule) ProcessPulse(modules map[string]Module) []string {
 	}
 	return []string{}
 }
+func (b *FlipFlopModule) Clone() Module {
+	clone := *b
+	clone.currentInputs = slices.Clone(b.currentInputs)
+	return &clone
+}
 func (b *FlipFlopModule) LowPulseCount() int  { return b.lowPulseCount }
 func (b *FlipFlopModule) HighPulseCount() int { return b.highPulseCount }
 
@@ -249,6 +311,11 @@ func (b *ConjunctionModule) ProcessPulse(modules map[string]Module) []string {
 	}
 	return b.destinations
 }
+func (b *ConjunctionModule) Clone() Module {
+	clone := *b
+	clone.currentInputs = maps.Clone(b.currentInputs)
+	return &clone
+}
 func (b *ConjunctionModule) LowPulseCount() int  { return b.lowPulseCount }
 func (b *ConjunctionModule) HighPulseCount() int { return b.highPulseCount }
 
@@ -261,56 +328,3 @@ func allHigh(inputs map[string]bool) bool {
 	}
 	return result
 }
-
-// greatest common divisor
-func gcd(nums ...int) int {
-	if len(nums) == 1 {
-		return nums[0]
-	}
-	a := nums[0]
-	if len(nums) == 2 {
-		b := nums[1]
-		if a == 0 {
-			return b
-		}
-		for b != 0 {
-			if a > b {
-				a = a - b
-			} else {
-				b = b - a
-			}
-		}
-		return a
-	}
-
-	overallGcd := gcd(a, nums[1])
-	for _, num := range nums[2:] {
-		overallGcd = gcd(overallGcd, num)
-	}
-	return overallGcd
-}
-
-// least common multiple
-func lcm(nums ...int) int {
-	if len(nums) == 1 {
-		return nums[0]
-	}
-	a := nums[0]
-	if len(nums) == 2 {
-		b := nums[1]
-		return abs((a / gcd(a, b)) * b)
-	}
-
-	overallLcm := lcm(a, nums[1])
-	for _, num := range nums[2:] {
-		overallLcm = lcm(overallLcm, num)
-	}
-	return overallLcm
-}
-
-func abs(a int) int {
-	if a < 0 {
-		return -a
-	}
-	return a
-}
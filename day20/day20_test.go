@@ -0,0 +1,11 @@
+package day20
+
+import (
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay20(t *testing.T) {
+	aoctest.Run(t, 20, aoctest.Parts(part1, part2))
+}
@@ -0,0 +1,135 @@
+package day21
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"aoc2023/grid"
+	"aoc2023/linalg"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(21, solver.NewParsed(parseGarden, func(garden Garden) (int, error) {
+		return garden.reachablePlots(64), nil
+	}, func(garden Garden) (int, error) {
+		return garden.extrapolatePlots(26501365)
+	}))
+}
+
+func part1(input string, steps int) (int, error) {
+	garden, err := parseGarden(input)
+	if err != nil {
+		return 0, err
+	}
+	return garden.reachablePlots(steps), nil
+}
+
+// Garden is the map of the garden and the starting position on it.
+type Garden struct {
+	tiles grid.Grid[byte]
+	start grid.Point
+}
+
+func (g Garden) reachablePlots(steps int) int {
+	cache = map[State]int{}
+	return countGardenPlots(State{g.start, steps}, g.tiles, make(map[grid.Point]bool))
+}
+
+func parseGarden(input string) (Garden, error) {
+	tiles, err := grid.ParseFunc(input, grid.OneOf(".#S"))
+	if err != nil {
+		return Garden{}, err
+	}
+	start, ok := tiles.Find(func(tile byte) bool { return tile == 'S' })
+	if !ok {
+		return Garden{}, errors.New("no starting position S")
+	}
+	return Garden{tiles, start}, nil
+}
+
+func part2(input string, steps int) (int, error) {
+	garden, err := parseGarden(input)
+	if err != nil {
+		return 0, err
+	}
+	return garden.extrapolatePlots(steps)
+}
+
+// only works for real input, not for example
+func (g Garden) extrapolatePlots(steps int) (int, error) {
+	mapSize := int64(g.tiles.Rows())
+
+	// f(steps)->count function is a 2nd degree polynomial (drawing graph makes it clear),
+	// which has a general form of f(x)=ax^2+bx+c
+	// there are 3 variables: a,b,c
+	// to solve those we need the results of 3 values for x
+	x1 := int64(steps) % mapSize
+	x2 := x1 + mapSize
+	x3 := x2 + mapSize
+	f_x1 := g.reachablePlots(int(x1))
+	f_x2 := g.reachablePlots(int(x2))
+	f_x3 := g.reachablePlots(int(x3))
+
+	results, err := linalg.SolveRat(linalg.Rats([][]int64{
+		{x1 * x1, x1, 1},
+		{x2 * x2, x2, 1},
+		{x3 * x3, x3, 1},
+	}), linalg.RatVector(int64(f_x1), int64(f_x2), int64(f_x3)))
+	if err != nil {
+		return 0, err
+	}
+
+	a := results[0]
+	b := results[1]
+	c := results[2]
+
+	// f(x) = (a*x + b)*x + c, evaluated exactly
+	x := big.NewRat(int64(steps), 1)
+	f := new(big.Rat).Mul(a, x)
+	f.Add(f, b).Mul(f, x).Add(f, c)
+	if !f.IsInt() {
+		return 0, fmt.Errorf("fitted polynomial is not integral at %d", steps)
+	}
+	if !f.Num().IsInt64() {
+		return 0, fmt.Errorf("fitted polynomial value %v at %d overflows int", f.Num(), steps)
+	}
+	result := int(f.Num().Int64())
+	return result, nil
+}
+
+type State struct {
+	curPos         grid.Point // position on the infinitely repeated garden
+	remainingSteps int
+}
+
+var cache map[State]int = make(map[State]int)
+
+func countGardenPlots(s State, tiles grid.Grid[byte], counted map[grid.Point]bool) int {
+	if _, ok := cache[s]; ok {
+		return 0
+	}
+	if tiles.AtWrapped(s.curPos) == '#' {
+		cache[s] = 0
+		return 0
+	}
+	if s.remainingSteps == 0 {
+		if counted[s.curPos] {
+			return 0
+		}
+		counted[s.curPos] = true
+		cache[s] = 1
+		return 1
+	}
+	result := 0
+	for _, direction := range []grid.Dir{grid.Right, grid.Down, grid.Left, grid.Up} {
+		result += countGardenPlots(State{
+			curPos:         s.curPos.Move(direction),
+			remainingSteps: s.remainingSteps - 1,
+		}, tiles, counted)
+	}
+
+	cache[s] = result
+	return result
+}
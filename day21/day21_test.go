@@ -0,0 +1,16 @@
+package day21
+
+import (
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay21(t *testing.T) {
+	aoctest.Run(t, 21, func(c aoctest.Case, input string) (any, error) {
+		if c.Part == 1 {
+			return part1(input, c.Param("steps"))
+		}
+		return part2(input, c.Param("steps"))
+	})
+}
@@ -1,87 +1,95 @@
-package main
+package day22
 
 import (
-	_ "embed"
-	"log"
-	"strconv"
-	"strings"
-	"time"
-
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
-)
-
-//go:embed example1.txt
-var example1 string
-
-//go:embed example2.txt
-var example2 string
-
-//go:embed input.txt
-var input string
 
-func main() {
-	exampleResult1 := part1(example1)
-	if exampleResult1 != 5 {
-		log.Fatalf("Part 1 wrong; acutal: %d\n", exampleResult1)
-	}
-	log.Printf("Part 1: %d\n", part1(input))
-
-	exampleResult2 := part2(example2)
-	if exampleResult2 != 7 {
-		log.Fatalf("Part 2 wrong; acutal: %d\n", exampleResult2)
-	}
-	log.Printf("Part 2: %d\n", part2(input))
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
 
+func init() {
+	solver.Register(22, solver.NewParsed(parseSnapshot, func(snapshot Snapshot) (int, error) {
+		return snapshot.settled().disintegrateCount(), nil
+	}, func(snapshot Snapshot) (int, error) {
+		return snapshot.settled().fallingBricksCount(), nil
+	}))
 }
 
-func part1(input string) int {
-	startTime := time.Now()
-	snapshot := parseSnapshot(input)
-	snapshot.settleBricks()
-	result := snapshot.disintegrateCount()
-	println("part1:", time.Since(startTime).String())
-	return result
+func part1(input string) (int, error) {
+	snapshot, err := parseSnapshot(input)
+	if err != nil {
+		return 0, err
+	}
+	return snapshot.settled().disintegrateCount(), nil
 }
 
-func part2(input string) int {
-	startTime := time.Now()
-	snapshot := parseSnapshot(input)
-	snapshot.settleBricks()
-	result := snapshot.fallingBricksCount()
-	println("part2:", time.Since(startTime).String())
-	return result
+func part2(input string) (int, error) {
+	snapshot, err := parseSnapshot(input)
+	if err != nil {
+		return 0, err
+	}
+	return snapshot.settled().fallingBricksCount(), nil
 }
 
-func parseSnapshot(s string) Snapshot {
-	brickId = 0
-	rawBricks := strings.Split(s, "\n")
+func parseSnapshot(s string) (Snapshot, error) {
 	bricks := Bricks{}
 
-	for _, rawBrick := range rawBricks {
-		brick := parseBrick(rawBrick)
+	for i, line := range inputs.Lines(s) {
+		brick, err := parseBrick(line, i+1)
+		if err != nil {
+			return Snapshot{}, err
+		}
 		bricks = append(bricks, &brick)
 	}
 
-	return Snapshot{bricks}
+	return Snapshot{bricks}, nil
 }
 
-var brickId int = 0
-
-func parseBrick(s string) Brick {
-	brickId++
-	coords := strings.Split(strings.Replace(s, "~", ",", 1), ",")
-	return Brick{
-		brickId,
-		parseVector3D(coords[0:3]),
-		parseVector3D(coords[3:6]),
+func parseBrick(s inputs.Span, id int) (Brick, error) {
+	rawStart, rawEnd, err := s.Cut("~")
+	if err != nil {
+		return Brick{}, err
+	}
+	start, err := parseVector3D(rawStart)
+	if err != nil {
+		return Brick{}, err
+	}
+	end, err := parseVector3D(rawEnd)
+	if err != nil {
+		return Brick{}, err
+	}
+	for i := range start {
+		if start[i] > end[i] {
+			return Brick{}, s.Errorf("brick ends before it starts")
+		}
+	}
+	if start[z] < 1 {
+		return Brick{}, s.Errorf("brick below the ground")
 	}
+	return Brick{
+		id,
+		start,
+		end,
+	}, nil
 }
 
 type Snapshot struct {
 	bricks Bricks
 }
 
+// settled returns a copy of the snapshot after all bricks fell down.
+func (this Snapshot) settled() Snapshot {
+	bricks := make(Bricks, len(this.bricks))
+	for i, brick := range this.bricks {
+		clone := *brick
+		bricks[i] = &clone
+	}
+	settled := Snapshot{bricks}
+	settled.settleBricks()
+	return settled
+}
+
 func (this *Snapshot) settleBricks() {
 	this.bricks.sort()
 	for i, brick := range this.bricks {
@@ -288,16 +296,15 @@ func (this Vector3D) subtract(other Vector3D) Vector3D {
 	return newVector
 }
 
-func parseVector3D(s []string) Vector3D {
-	result := make([]int, len(s))
-	var err error
-	for i := range s {
-		result[i], err = strconv.Atoi(s[i])
-	}
+func parseVector3D(s inputs.Span) (Vector3D, error) {
+	coords, err := s.IntsSep(",")
 	if err != nil {
-		log.Fatalln("failed parsing string")
+		return nil, err
 	}
-	return result
+	if len(coords) != 3 {
+		return nil, s.Errorf("want x,y,z, got")
+	}
+	return coords, nil
 }
 
 func max(a, b int) int {
@@ -0,0 +1,11 @@
+package day22
+
+import (
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay22(t *testing.T) {
+	aoctest.Run(t, 22, aoctest.Parts(part1, part2))
+}
@@ -0,0 +1,206 @@
+package day23
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/maps"
+
+	"aoc2023/grid"
+	"aoc2023/inputs"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(23, solver.NewParsed(parseTrails, func(trails Trails) (int, error) {
+		return trails.longestHike(false)
+	}, func(trails Trails) (int, error) {
+		return trails.longestHike(true)
+	}))
+}
+
+func solve(input string, part int) (int, error) {
+	trails, err := parseTrails(input)
+	if err != nil {
+		return 0, err
+	}
+	return trails.longestHike(part == 2)
+}
+
+// Trails is the map of the hiking trails with its start and end tile.
+type Trails struct {
+	tiles      grid.Grid[byte]
+	start, end grid.Point
+}
+
+func parseTrails(input string) (Trails, error) {
+	lines := inputs.Lines(input)
+	tiles, err := grid.ParseLines(lines, grid.OneOf("#.^>v<"))
+	if err != nil {
+		return Trails{}, err
+	}
+	if tiles.Rows() < 2 || tiles.Cols() < 3 {
+		return Trails{}, fmt.Errorf("trail map of %dx%d tiles is too small", tiles.Rows(), tiles.Cols())
+	}
+	start := grid.Point{Row: 0, Col: 1}
+	end := grid.Point{Row: tiles.Rows() - 1, Col: tiles.Cols() - 2}
+	if tiles.At(start) != '.' {
+		return Trails{}, lines[start.Row].Slice(start.Col, start.Col+1).Errorf("want path at the start, got")
+	}
+	if tiles.At(end) != '.' {
+		return Trails{}, lines[end.Row].Slice(end.Col, end.Col+1).Errorf("want path at the end, got")
+	}
+	return Trails{tiles, start, end}, nil
+}
+
+// longestHike returns the length of the longest hike from start to end,
+// which may walk up the slopes if ignoreSlopes is set.
+func (t Trails) longestHike(ignoreSlopes bool) (int, error) {
+	graph := buildGraph(t.tiles, t.start, t.end, ignoreSlopes)
+
+	result, ok := longestDistance(graph.get(t.start), graph.get(t.end), map[*Node]bool{})
+	if !ok {
+		return 0, errors.New("no path from start to end")
+	}
+	return result, nil
+}
+
+func longestDistance(current *Node, target *Node, visitedNodes map[*Node]bool) (int, bool) {
+	if current == target {
+		return 0, true
+	}
+	if visitedNodes[current] {
+		return 0, false
+	}
+
+	newVisitedNodes := maps.Clone(visitedNodes)
+	newVisitedNodes[current] = true
+
+	maxDistance := 0
+	isValid := false
+	for next, dstCurrentToNext := range current.neighbors {
+		dstNextToTarget, ok := longestDistance(next, target, newVisitedNodes)
+		newDistance := dstCurrentToNext + dstNextToTarget
+		if ok && newDistance >= maxDistance {
+			maxDistance = newDistance
+			isValid = true
+		}
+	}
+
+	return maxDistance, isValid
+}
+
+type Node struct {
+	pos        grid.Point
+	discovered bool
+	neighbors  map[*Node]int
+}
+
+type Nodes map[grid.Point]*Node
+
+func (this Nodes) get(v grid.Point) *Node {
+	if _, ok := this[v]; !ok {
+		this[v] = &Node{
+			pos:       v,
+			neighbors: map[*Node]int{},
+		}
+	}
+	return this[v]
+}
+
+func buildGraph(tiles grid.Grid[byte], start grid.Point, end grid.Point, ignoreSlopes bool) Nodes {
+	nodes := Nodes{}
+	nodes.get(end).discovered = true
+
+	toDiscover := []Position{{start, grid.Down}}
+	for len(toDiscover) != 0 {
+		curPos := toDiscover[0]
+		toDiscover = toDiscover[1:]
+		currentNode := nodes.get(curPos.position)
+		currentNode.discovered = true
+
+		directions := []grid.Dir{
+			curPos.direction,
+			curPos.direction.TurnLeft(),
+			curPos.direction.TurnRight(),
+		}
+
+		for _, direction := range directions {
+			neighbor, distance, isValid := Position{curPos.position.Move(direction), direction}.discoverNode(tiles, ignoreSlopes)
+			if isValid {
+				neighborNode := nodes.get(neighbor.position)
+				currentNode.neighbors[neighborNode] = distance + 1
+				if ignoreSlopes {
+					neighborNode.neighbors[currentNode] = distance + 1
+				}
+				if !neighborNode.discovered {
+					toDiscover = append(toDiscover, neighbor)
+				}
+			}
+		}
+	}
+	return nodes
+}
+
+func (this Position) next(direction grid.Dir, tiles grid.Grid[byte]) (Position, bool) {
+	nextPos := this.position.Move(direction)
+	if tiles.In(nextPos) && tiles.At(nextPos) != '#' {
+		return Position{nextPos, direction}, true
+	}
+	return Position{nextPos, direction}, false
+}
+
+func (this Position) discoverNode(tiles grid.Grid[byte], ignoreSlopes bool) (Position, int, bool) {
+	current := this
+	distance := 0
+	start := grid.Point{Row: 0, Col: 1}
+	end := grid.Point{Row: tiles.Rows() - 1, Col: tiles.Cols() - 2}
+
+	for {
+		if current.position == start || current.position == end {
+			return current, distance, true
+		}
+
+		if !tiles.In(current.position) {
+			return current, distance, false
+		}
+
+		currentTile := tiles.At(current.position)
+		if currentTile == '#' {
+			return current, distance, false
+		}
+		if !ignoreSlopes && currentTile == '>' && current.direction != grid.Right {
+			return current, distance, false
+		}
+		if !ignoreSlopes && currentTile == 'v' && current.direction != grid.Down {
+			return current, distance, false
+		}
+
+		nextPositions := []Position{}
+		if next, ok := current.next(current.direction.TurnLeft(), tiles); ok {
+			nextPositions = append(nextPositions, next)
+		}
+		if next, ok := current.next(current.direction.TurnRight(), tiles); ok {
+			nextPositions = append(nextPositions, next)
+		}
+		if next, ok := current.next(current.direction, tiles); ok {
+			nextPositions = append(nextPositions, next)
+		}
+
+		validMovesCount := len(nextPositions)
+		if validMovesCount >= 2 {
+			return current, distance, true
+		}
+		if validMovesCount == 0 {
+			return current, distance, false
+		}
+
+		distance++
+		current = nextPositions[0]
+	}
+}
+
+type Position struct {
+	position  grid.Point
+	direction grid.Dir
+}
@@ -0,0 +1,13 @@
+package day23
+
+import (
+	"testing"
+
+	"aoc2023/aoctest"
+)
+
+func TestDay23(t *testing.T) {
+	aoctest.Run(t, 23, func(c aoctest.Case, input string) (any, error) {
+		return solve(input, c.Part)
+	})
+}
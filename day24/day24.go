@@ -0,0 +1,393 @@
+package day24
+
+import (
+	"fmt"
+	"math/big"
+
+	"aoc2023/inputs"
+	"aoc2023/linalg"
+	"aoc2023/solver"
+)
+
+func init() {
+	solver.Register(24, solver.NewParsed(parseHailstones, func(hailstones []Hailstone) (int, error) {
+		return countIntersections(hailstones, 200000000000000, 400000000000000), nil
+	}, throwRock))
+}
+
+func part1(input string, from, to int64) (int, error) {
+	hailstones, err := parseHailstones(input)
+	if err != nil {
+		return 0, err
+	}
+	return countIntersections(hailstones, from, to), nil
+}
+
+func part2(input string) (int, error) {
+	hailstones, err := parseHailstones(input)
+	if err != nil {
+		return 0, err
+	}
+	return throwRock(hailstones)
+}
+
+// countIntersections counts the pairs of hailstones whose future paths in the
+// xy-plane meet within the test area, listing every pair on solver.Debug.
+func countIntersections(hailstones []Hailstone, from, to int64) int {
+	area := Area{big.NewRat(from, 1), big.NewRat(to, 1)}
+	count := 0
+	for i := 0; i < len(hailstones)-1; i++ {
+		for j := i + 1; j < len(hailstones); j++ {
+			result := area.intersect(hailstones[i], hailstones[j])
+			if result.counts() {
+				count++
+			}
+			fmt.Fprintf(solver.Debug, "%d: %v\n%d: %v\n  %v\n", i+1, hailstones[i], j+1, hailstones[j], result)
+		}
+	}
+	return count
+}
+
+// Area is the square test area [from,to]×[from,to].
+type Area struct {
+	from, to *big.Rat
+}
+
+type Verdict int
+
+const (
+	Crossing Verdict = iota
+	CrossingOutside
+	CrossingInPastA
+	CrossingInPastB
+	CrossingInPastBoth
+	Parallel
+	SamePath
+	SamePathOutside
+)
+
+func (v Verdict) String() string {
+	return [...]string{
+		"paths cross inside the test area",
+		"paths cross outside the test area",
+		"paths crossed in the past for A",
+		"paths crossed in the past for B",
+		"paths crossed in the past for both",
+		"paths are parallel",
+		"paths are identical and overlap inside the test area",
+		"paths are identical but do not overlap inside the test area",
+	}[v]
+}
+
+type PathIntersection struct {
+	Verdict
+	at [2]*big.Rat // only set for crossings
+}
+
+func (p PathIntersection) counts() bool {
+	return p.Verdict == Crossing || p.Verdict == SamePath
+}
+
+func (p PathIntersection) String() string {
+	if p.at[0] == nil {
+		return p.Verdict.String()
+	}
+	return fmt.Sprintf("%v (at x=%s, y=%s)", p.Verdict, p.at[0].FloatString(3), p.at[1].FloatString(3))
+}
+
+// intersect solves pa + t*va = pb + s*vb in the xy-plane exactly.
+// Crossing both sides with vb respectively va gives
+// t = (d × vb) / (va × vb) and s = (d × va) / (va × vb) with d = pb - pa.
+func (area Area) intersect(a, b Hailstone) PathIntersection {
+	pa, va := xy(a.p), xy(a.v)
+	pb, vb := xy(b.p), xy(b.v)
+	d := [2]*big.Int{new(big.Int).Sub(pb[0], pa[0]), new(big.Int).Sub(pb[1], pa[1])}
+
+	denominator := cross2(va, vb)
+	if denominator.Sign() == 0 {
+		if cross2(d, va).Sign() != 0 || cross2(d, vb).Sign() != 0 {
+			return PathIntersection{Verdict: Parallel}
+		}
+		if isZero2(va) && isZero2(vb) && !isZero2(d) {
+			// two hailstones standing still on different points
+			return PathIntersection{Verdict: Parallel}
+		}
+		if area.overlap(pa, va, d, vb) {
+			return PathIntersection{Verdict: SamePath}
+		}
+		return PathIntersection{Verdict: SamePathOutside}
+	}
+
+	t := new(big.Rat).SetFrac(cross2(d, vb), denominator)
+	s := new(big.Rat).SetFrac(cross2(d, va), denominator)
+	switch {
+	case t.Sign() < 0 && s.Sign() < 0:
+		return PathIntersection{Verdict: CrossingInPastBoth}
+	case t.Sign() < 0:
+		return PathIntersection{Verdict: CrossingInPastA}
+	case s.Sign() < 0:
+		return PathIntersection{Verdict: CrossingInPastB}
+	}
+
+	at := [2]*big.Rat{}
+	for k := range at {
+		at[k] = new(big.Rat).Mul(t, new(big.Rat).SetInt(va[k]))
+		at[k].Add(at[k], new(big.Rat).SetInt(pa[k]))
+	}
+	if area.contains(at[0]) && area.contains(at[1]) {
+		return PathIntersection{Crossing, at}
+	}
+	return PathIntersection{CrossingOutside, at}
+}
+
+func (area Area) contains(x *big.Rat) bool {
+	return area.from.Cmp(x) <= 0 && x.Cmp(area.to) <= 0
+}
+
+// overlap reports whether two hailstones moving along the same line, b
+// starting at pa + d, are ever at the same point of the test area, not
+// necessarily at the same time. Points on the line are written as pa + r*u
+// and every condition restricts r to an interval.
+func (area Area) overlap(pa, va, d, vb [2]*big.Int) bool {
+	u := va
+	if isZero2(va) {
+		u = vb
+	}
+	uu := dot2(u, u)
+	if uu.Sign() == 0 {
+		// both hailstones stand still, on the same point or never together
+		return isZero2(d) && area.contains(new(big.Rat).SetInt(pa[0])) && area.contains(new(big.Rat).SetInt(pa[1]))
+	}
+
+	r := interval{}
+	// A is at r = t*|va|/|u| for t >= 0, which is r >= 0 or only r = 0 if it stands still
+	r.restrict(new(big.Rat), dot2(va, u).Sign())
+	// B is at r = r0 + s*k with r0 = d·u/u·u and k = vb·u/u·u
+	r.restrict(new(big.Rat).SetFrac(dot2(d, u), uu), dot2(vb, u).Sign())
+	for k := 0; k < 2; k++ {
+		if u[k].Sign() == 0 {
+			if !area.contains(new(big.Rat).SetInt(pa[k])) {
+				return false
+			}
+			continue
+		}
+		// from <= pa_k + r*u_k <= to
+		lo := new(big.Rat).Sub(area.from, new(big.Rat).SetInt(pa[k]))
+		hi := new(big.Rat).Sub(area.to, new(big.Rat).SetInt(pa[k]))
+		uk := new(big.Rat).SetInt(u[k])
+		lo.Quo(lo, uk)
+		hi.Quo(hi, uk)
+		if u[k].Sign() < 0 {
+			lo, hi = hi, lo
+		}
+		r.restrict(lo, 1)
+		r.restrict(hi, -1)
+	}
+	return !r.isEmpty()
+}
+
+// interval is a closed interval of rationals, nil bounds are unbounded.
+type interval struct {
+	lo, hi *big.Rat
+}
+
+// restrict intersects the interval with [x,∞) for direction > 0,
+// (-∞,x] for direction < 0 and [x,x] for direction == 0.
+func (i *interval) restrict(x *big.Rat, direction int) {
+	if direction >= 0 && (i.lo == nil || x.Cmp(i.lo) > 0) {
+		i.lo = x
+	}
+	if direction <= 0 && (i.hi == nil || x.Cmp(i.hi) < 0) {
+		i.hi = x
+	}
+}
+
+func (i interval) isEmpty() bool {
+	return i.lo != nil && i.hi != nil && i.lo.Cmp(i.hi) > 0
+}
+
+func xy(a [3]int64) [2]*big.Int {
+	return [2]*big.Int{big.NewInt(a[0]), big.NewInt(a[1])}
+}
+
+func cross2(a, b [2]*big.Int) *big.Int {
+	return new(big.Int).Sub(new(big.Int).Mul(a[0], b[1]), new(big.Int).Mul(a[1], b[0]))
+}
+
+func dot2(a, b [2]*big.Int) *big.Int {
+	return new(big.Int).Add(new(big.Int).Mul(a[0], b[0]), new(big.Int).Mul(a[1], b[1]))
+}
+
+func isZero2(a [2]*big.Int) bool {
+	return a[0].Sign() == 0 && a[1].Sign() == 0
+}
+
+// The rock at p with velocity v hits hailstone i at time t_i:
+// p + t_i*v = p_i + t_i*v_i, so (p - p_i) and (v - v_i) are parallel:
+//
+//	(p - p_i) × (v - v_i) = p×v - p×v_i - p_i×v + p_i×v_i = 0
+//
+// The only nonlinear term p×v is the same for every hailstone, so subtracting
+// the equations of two hailstones i and j leaves three linear equations:
+//
+//	p×(v_j - v_i) + (p_j - p_i)×v = p_j×v_j - p_i×v_i
+//
+// Pairs are added until the 6 unknowns are determined and the system is solved exactly.
+func throwRock(hailstones []Hailstone) (int, error) {
+	rock, err := findRock(hailstones)
+	if err != nil {
+		return 0, err
+	}
+
+	sum := new(big.Int)
+	for _, coord := range rock.p {
+		sum.Add(sum, coord)
+	}
+	if !sum.IsInt64() {
+		return 0, fmt.Errorf("sum of rock coordinates %v overflows int", sum)
+	}
+	return int(sum.Int64()), nil
+}
+
+func findRock(hailstones []Hailstone) (Rock, error) {
+	a := [][]*big.Rat{}
+	b := []*big.Rat{}
+	for j := 1; j < len(hailstones); j++ {
+		rows, rhs := pairEquations(hailstones[0], hailstones[j])
+		a = append(a, rows...)
+		b = append(b, rhs...)
+		if len(a) >= 6 && linalg.RankRat(a) == 6 {
+			break
+		}
+	}
+	solution, err := linalg.SolveRat(a, b)
+	if err != nil {
+		return Rock{}, fmt.Errorf("no unique rock trajectory: %w", err)
+	}
+
+	rock := Rock{}
+	for k := 0; k < 3; k++ {
+		if !solution[k].IsInt() || !solution[k+3].IsInt() {
+			return Rock{}, fmt.Errorf("rock trajectory %v is not integral", solution)
+		}
+		rock.p[k] = new(big.Int).Set(solution[k].Num())
+		rock.v[k] = new(big.Int).Set(solution[k+3].Num())
+	}
+	for i, h := range hailstones {
+		if !rock.hits(h) {
+			return Rock{}, fmt.Errorf("rock %v misses hailstone %d (%v)", rock, i+1, h)
+		}
+	}
+	return rock, nil
+}
+
+// pairEquations returns the rows over (px,py,pz,vx,vy,vz) and right-hand sides of
+// p×(v_j - v_i) + (p_j - p_i)×v = p_j×v_j - p_i×v_i.
+func pairEquations(hi, hj Hailstone) ([][]*big.Rat, []*big.Rat) {
+	d := sub(hj.v, hi.v)
+	e := sub(hj.p, hi.p)
+	rows := [][]int64{
+		{0, d[2], -d[1], 0, -e[2], e[1]},
+		{-d[2], 0, d[0], e[2], 0, -e[0]},
+		{d[1], -d[0], 0, -e[1], e[0], 0},
+	}
+	a := linalg.Rats(rows)
+	rhs := make([]*big.Rat, 3)
+	cj := cross(toBig(hj.p), toBig(hj.v))
+	ci := cross(toBig(hi.p), toBig(hi.v))
+	for k := range rhs {
+		rhs[k] = new(big.Rat).SetInt(new(big.Int).Sub(cj[k], ci[k]))
+	}
+	return a, rhs
+}
+
+type Hailstone struct {
+	p, v [3]int64
+}
+
+func (h Hailstone) String() string {
+	return fmt.Sprintf("%d, %d, %d @ %d, %d, %d", h.p[0], h.p[1], h.p[2], h.v[0], h.v[1], h.v[2])
+}
+
+func parseHailstones(s string) ([]Hailstone, error) {
+	lines := inputs.Lines(s)
+	hailstones := make([]Hailstone, len(lines))
+	for i, line := range lines {
+		var err error
+		hailstones[i], err = parseHailstone(line)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return hailstones, nil
+}
+
+func parseHailstone(s inputs.Span) (Hailstone, error) {
+	position, velocity, err := s.Cut("@")
+	if err != nil {
+		return Hailstone{}, err
+	}
+	h := Hailstone{}
+	for _, vector := range []struct {
+		raw    inputs.Span
+		values *[3]int64
+	}{{position, &h.p}, {velocity, &h.v}} {
+		coords := vector.raw.Split(",")
+		if len(coords) != 3 {
+			return Hailstone{}, vector.raw.TrimSpace().Errorf("want x, y, z, got")
+		}
+		for k, coord := range coords {
+			vector.values[k], err = coord.TrimSpace().Int64()
+			if err != nil {
+				return Hailstone{}, err
+			}
+		}
+	}
+	return h, nil
+}
+
+type Rock struct {
+	p, v [3]*big.Int
+}
+
+func (r Rock) String() string {
+	return fmt.Sprintf("%d, %d, %d @ %d, %d, %d", r.p[0], r.p[1], r.p[2], r.v[0], r.v[1], r.v[2])
+}
+
+// hits reports whether the rock and the hailstone are at the same position at some time t >= 0.
+func (r Rock) hits(h Hailstone) bool {
+	var t *big.Rat
+	for k := 0; k < 3; k++ {
+		// p_k + t*v_k = hp_k + t*hv_k  =>  t*(v_k - hv_k) = hp_k - p_k
+		dv := new(big.Int).Sub(r.v[k], big.NewInt(h.v[k]))
+		dp := new(big.Int).Sub(big.NewInt(h.p[k]), r.p[k])
+		if dv.Sign() == 0 {
+			if dp.Sign() != 0 {
+				return false
+			}
+			continue
+		}
+		tk := new(big.Rat).SetFrac(dp, dv)
+		if t == nil {
+			t = tk
+		} else if t.Cmp(tk) != 0 {
+			return false
+		}
+	}
+	return t == nil || t.Sign() >= 0
+}
+
+func sub(a, b [3]int64) [3]int64 {
+	return [3]int64{a[0] - b[0], a[1] - b[1], a[2] - b[2]}
+}
+
+func toBig(a [3]int64) [3]*big.Int {
+	return [3]*big.Int{big.NewInt(a[0]), big.NewInt(a[1]), big.NewInt(a[2])}
+}
+
+func cross(a, b [3]*big.Int) [3]*big.Int {
+	product := func(i, j int) *big.Int {
+		return new(big.Int).Sub(new(big.Int).Mul(a[i], b[j]), new(big.Int).Mul(a[j], b[i]))
+	}
+	return [3]*big.Int{product(1, 2), product(2, 0), product(0, 1)}
+}
@@ -0,0 +1,51 @@
+package day24
+
+import (
+	"math/big"
+	"testing"
+
+	"aoc2023/aoctest"
+	"aoc2023/inputs"
+)
+
+func TestDay24(t *testing.T) {
+	aoctest.Run(t, 24, func(c aoctest.Case, input string) (any, error) {
+		if c.Part == 1 {
+			return part1(input, int64(c.Param("from")), int64(c.Param("to")))
+		}
+		return part2(input)
+	})
+}
+
+func TestIntersectEdgeCases(t *testing.T) {
+	area := Area{big.NewRat(0, 1), big.NewRat(10, 1)}
+	tests := []struct {
+		a, b string
+		want Verdict
+	}{
+		{"1, 1, 0 @ 1, 1, 0", "2, 2, 0 @ 2, 2, 0", SamePath},
+		{"5, 5, 0 @ 1, 1, 0", "3, 3, 0 @ 1, 1, 0", SamePath},
+		{"1, 1, 0 @ -1, -1, 0", "20, 20, 0 @ 1, 1, 0", SamePathOutside},
+		{"0, 0, 0 @ 1, 0, 0", "0, 1, 0 @ 1, 0, 0", Parallel},
+		{"0, 10, 0 @ 1, 0, 0", "10, 0, 0 @ 0, 1, 0", Crossing},
+		{"0, 10, 0 @ 1, 0, 0", "11, 0, 0 @ 0, 1, 0", CrossingOutside},
+		{"0, 10, 0 @ -1, 0, 0", "5, 0, 0 @ 0, 1, 0", CrossingInPastA},
+		{"1, 1, 0 @ 0, 0, 0", "5, 5, 0 @ 0, 0, 0", Parallel},
+		{"1, 1, 0 @ 0, 0, 0", "1, 1, 0 @ 0, 0, 0", SamePath},
+		{"1, 1, 0 @ 0, 0, 0", "5, 5, 0 @ 1, 1, 0", SamePathOutside},
+	}
+	for _, tt := range tests {
+		a, err := parseHailstone(inputs.Lines(tt.a)[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := parseHailstone(inputs.Lines(tt.b)[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := area.intersect(a, b)
+		if got.Verdict != tt.want {
+			t.Errorf("%s / %s: got %q, want %q", tt.a, tt.b, got.Verdict, tt.want)
+		}
+	}
+}
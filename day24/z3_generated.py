@@ -1,11 +0,0 @@
-from z3 import *
-px = Int("px")
-py = Int("py")
-pz = Int("pz")
-vx = Int("vx")
-vy = Int("vy")
-vz = Int("vz")
-t1 = Int("t1")
-t2 = Int("t2")
-t3 = Int("t3")
-solve(0 == px + t1*(vx-(21)) - (368925240582247),0 == py + t1*(vy-(-126)) - (337542061908847),0 == pz + t1*(vz-(-9)) - (298737178993847),t1 > 0,0 == px + t2*(vx-(-21)) - (287668477092999),0 == py + t2*(vy-(-15)) - (306868689869154),0 == pz + t2*(vz-(29)) - (240173335647821),t2 > 0,0 == px + t3*(vx-(-25)) - (172063062341522),0 == py + t3*(vy-(-38)) - (378381220662744),0 == pz + t3*(vz-(-64)) - (223621999511007),t3 > 0,)
@@ -2,4 +2,4 @@ module aoc2023
 
 go 1.21.3
 
-require golang.org/x/exp v0.0.0-20231206192017-f3f8817b8deb // indirect
+require golang.org/x/exp v0.0.0-20231206192017-f3f8817b8deb
@@ -0,0 +1,244 @@
+// Package grid provides the 2D tile grids most puzzles are drawn on.
+package grid
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"aoc2023/inputs"
+)
+
+// Grid is a rectangular grid of cells stored row by row.
+type Grid[T any] struct {
+	rows, cols int
+	cells      []T
+}
+
+// New returns a grid with all cells set to their zero value.
+func New[T any](rows, cols int) Grid[T] {
+	return Grid[T]{rows, cols, make([]T, rows*cols)}
+}
+
+// Filled returns a grid with all cells set to value.
+func Filled[T any](rows, cols int, value T) Grid[T] {
+	g := New[T](rows, cols)
+	for i := range g.cells {
+		g.cells[i] = value
+	}
+	return g
+}
+
+// Parse reads a grid of bytes, one line of puzzle text per row.
+func Parse(s string) (Grid[byte], error) {
+	return ParseFunc(s, Bytes)
+}
+
+// ParseFunc reads a grid of puzzle text and converts every byte with f.
+func ParseFunc[T any](s string, f func(b byte) (T, error)) (Grid[T], error) {
+	return ParseLines(inputs.Lines(s), f)
+}
+
+// ParseLines reads a grid of lines of puzzle text and converts every byte
+// with f. All lines must have the length of the first line; errors of f are
+// reported as inputs.ParseError at the byte.
+func ParseLines[T any](lines []inputs.Span, f func(b byte) (T, error)) (Grid[T], error) {
+	if len(lines) == 0 || lines[0].Text == "" {
+		return Grid[T]{}, errors.New("grid: empty input")
+	}
+	g := New[T](len(lines), len(lines[0].Text))
+	for row, line := range lines {
+		if len(line.Text) != g.cols {
+			return Grid[T]{}, line.Errorf("want %d columns, got %d in", g.cols, len(line.Text))
+		}
+		for col := range line.Text {
+			value, err := f(line.Text[col])
+			if err != nil {
+				return Grid[T]{}, line.Slice(col, col+1).Errorf("%v", err)
+			}
+			g.cells[row*g.cols+col] = value
+		}
+	}
+	return g, nil
+}
+
+// Bytes accepts any byte as cell; it is the conversion of Parse.
+func Bytes(b byte) (byte, error) {
+	return b, nil
+}
+
+// OneOf returns a conversion for ParseFunc that only accepts the given bytes.
+func OneOf(allowed string) func(b byte) (byte, error) {
+	return func(b byte) (byte, error) {
+		if strings.IndexByte(allowed, b) < 0 {
+			return 0, fmt.Errorf("want one of %q, got", allowed)
+		}
+		return b, nil
+	}
+}
+
+// Digits converts decimal digits to their value.
+func Digits(b byte) (int, error) {
+	if b < '0' || b > '9' {
+		return 0, errors.New("want digit, got")
+	}
+	return int(b - '0'), nil
+}
+
+func (g Grid[T]) Rows() int { return g.rows }
+func (g Grid[T]) Cols() int { return g.cols }
+
+// In reports whether p lies within the grid.
+func (g Grid[T]) In(p Point) bool {
+	return p.Row >= 0 && p.Row < g.rows && p.Col >= 0 && p.Col < g.cols
+}
+
+func (g Grid[T]) At(p Point) T {
+	return g.cells[g.index(p)]
+}
+
+func (g Grid[T]) Set(p Point, value T) {
+	g.cells[g.index(p)] = value
+}
+
+func (g Grid[T]) index(p Point) int {
+	if !g.In(p) {
+		panic(fmt.Sprintf("grid: %v out of bounds %dx%d", p, g.rows, g.cols))
+	}
+	return p.Row*g.cols + p.Col
+}
+
+// Wrap maps a point on the grid repeated infinitely in every direction to
+// its position within the grid and the tile it lies on; (0,0) is the grid
+// itself, (1,0) the copy below it.
+func (g Grid[T]) Wrap(p Point) (local, tile Point) {
+	tile = Point{floorDiv(p.Row, g.rows), floorDiv(p.Col, g.cols)}
+	local = Point{p.Row - tile.Row*g.rows, p.Col - tile.Col*g.cols}
+	return local, tile
+}
+
+// AtWrapped returns the cell at p on the infinitely repeated grid.
+func (g Grid[T]) AtWrapped(p Point) T {
+	local, _ := g.Wrap(p)
+	return g.At(local)
+}
+
+func floorDiv(a, b int) int {
+	if a < 0 {
+		return -((-a + b - 1) / b)
+	}
+	return a / b
+}
+
+// Neighbors4 returns the orthogonal neighbors of p that lie within the grid.
+func (g Grid[T]) Neighbors4(p Point) []Point {
+	return g.inside(p.Neighbors4())
+}
+
+// Neighbors8 returns the orthogonal and diagonal neighbors of p that lie within the grid.
+func (g Grid[T]) Neighbors8(p Point) []Point {
+	return g.inside(p.Neighbors8())
+}
+
+func (g Grid[T]) inside(points []Point) []Point {
+	result := points[:0]
+	for _, p := range points {
+		if g.In(p) {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// Each calls f for every cell, row by row.
+func (g Grid[T]) Each(f func(p Point, value T)) {
+	for i, value := range g.cells {
+		f(Point{i / g.cols, i % g.cols}, value)
+	}
+}
+
+// Find returns the first cell, row by row, for which match returns true.
+func (g Grid[T]) Find(match func(value T) bool) (Point, bool) {
+	for i, value := range g.cells {
+		if match(value) {
+			return Point{i / g.cols, i % g.cols}, true
+		}
+	}
+	return Point{}, false
+}
+
+// Row returns the cells of a row; changing them changes the grid.
+func (g Grid[T]) Row(row int) []T {
+	return g.cells[row*g.cols : (row+1)*g.cols]
+}
+
+// Col returns a copy of the cells of a column.
+func (g Grid[T]) Col(col int) []T {
+	result := make([]T, g.rows)
+	for row := range result {
+		result[row] = g.cells[row*g.cols+col]
+	}
+	return result
+}
+
+func (g Grid[T]) Clone() Grid[T] {
+	cells := make([]T, len(g.cells))
+	copy(cells, g.cells)
+	return Grid[T]{g.rows, g.cols, cells}
+}
+
+// Pad returns a copy of the grid surrounded by n cells of fill on every side.
+func (g Grid[T]) Pad(n int, fill T) Grid[T] {
+	padded := Filled(g.rows+2*n, g.cols+2*n, fill)
+	for row := 0; row < g.rows; row++ {
+		copy(padded.Row(row + n)[n:], g.Row(row))
+	}
+	return padded
+}
+
+// Transpose returns a copy of the grid mirrored along its main diagonal.
+func (g Grid[T]) Transpose() Grid[T] {
+	result := New[T](g.cols, g.rows)
+	g.Each(func(p Point, value T) {
+		result.Set(Point{p.Col, p.Row}, value)
+	})
+	return result
+}
+
+// RotateClockwise returns a copy of the grid rotated by 90° clockwise.
+func (g Grid[T]) RotateClockwise() Grid[T] {
+	result := New[T](g.cols, g.rows)
+	g.Each(func(p Point, value T) {
+		result.Set(Point{p.Col, g.rows - 1 - p.Row}, value)
+	})
+	return result
+}
+
+// RotateCounterClockwise returns a copy of the grid rotated by 90° counterclockwise.
+func (g Grid[T]) RotateCounterClockwise() Grid[T] {
+	result := New[T](g.cols, g.rows)
+	g.Each(func(p Point, value T) {
+		result.Set(Point{g.cols - 1 - p.Col, p.Row}, value)
+	})
+	return result
+}
+
+// String renders the grid one row per line; byte and rune cells are
+// written as characters, everything else with fmt.
+func (g Grid[T]) String() string {
+	builder := strings.Builder{}
+	for row := 0; row < g.rows; row++ {
+		for _, value := range g.Row(row) {
+			switch v := any(value).(type) {
+			case byte:
+				builder.WriteByte(v)
+			case rune:
+				builder.WriteRune(v)
+			default:
+				fmt.Fprint(&builder, v)
+			}
+		}
+		builder.WriteByte('\n')
+	}
+	return builder.String()
+}
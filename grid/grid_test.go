@@ -0,0 +1,90 @@
+package grid
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTurns(t *testing.T) {
+	for i, d := range Dirs4 {
+		if got, want := d.TurnRight(), Dirs4[(i+1)%4]; got != want {
+			t.Errorf("%v.TurnRight() = %v, want %v", d, got, want)
+		}
+		if got, want := d.TurnLeft(), Dirs4[(i+3)%4]; got != want {
+			t.Errorf("%v.TurnLeft() = %v, want %v", d, got, want)
+		}
+		if got, want := d.Reverse(), Dirs4[(i+2)%4]; got != want {
+			t.Errorf("%v.Reverse() = %v, want %v", d, got, want)
+		}
+	}
+}
+
+func TestTransformations(t *testing.T) {
+	g, err := Parse("abc\ndef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		got  Grid[byte]
+		want string
+	}{
+		{"transpose", g.Transpose(), "ad\nbe\ncf\n"},
+		{"clockwise", g.RotateClockwise(), "da\neb\nfc\n"},
+		{"counterclockwise", g.RotateCounterClockwise(), "cf\nbe\nad\n"},
+		{"full turn", g.RotateClockwise().RotateClockwise().RotateClockwise().RotateClockwise(), "abc\ndef\n"},
+		{"pad", g.Pad(1, '.'), ".....\n.abc.\n.def.\n.....\n"},
+	}
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%s: got\n%swant\n%s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWrap(t *testing.T) {
+	g := New[int](3, 4)
+	tests := []struct {
+		p           Point
+		local, tile Point
+	}{
+		{Point{0, 0}, Point{0, 0}, Point{0, 0}},
+		{Point{2, 3}, Point{2, 3}, Point{0, 0}},
+		{Point{3, 4}, Point{0, 0}, Point{1, 1}},
+		{Point{-1, -1}, Point{2, 3}, Point{-1, -1}},
+		{Point{-3, -9}, Point{0, 3}, Point{-1, -3}},
+	}
+	for _, tt := range tests {
+		local, tile := g.Wrap(tt.p)
+		if local != tt.local || tile != tt.tile {
+			t.Errorf("Wrap(%v) = %v, %v; want %v, %v", tt.p, local, tile, tt.local, tt.tile)
+		}
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g := New[int](2, 2)
+	if got, want := g.Neighbors4(Point{0, 0}), []Point{{0, 1}, {1, 0}}; !slices.Equal(got, want) {
+		t.Errorf("Neighbors4 = %v, want %v", got, want)
+	}
+	if got, want := g.Neighbors8(Point{0, 0}), []Point{{0, 1}, {1, 1}, {1, 0}}; !slices.Equal(got, want) {
+		t.Errorf("Neighbors8 = %v, want %v", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"..\n...", `line 2, column 1: want 2 columns, got 3 in "..."`},
+		{"..\n.x", `line 2, column 2: want one of ".#", got "x"`},
+		{"", "grid: empty input"},
+	}
+	for _, tt := range tests {
+		_, err := ParseFunc(tt.input, OneOf(".#"))
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("ParseFunc(%q) = %v, want %s", tt.input, err, tt.want)
+		}
+	}
+}
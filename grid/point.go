@@ -0,0 +1,118 @@
+package grid
+
+// Point is a position on a grid; rows grow downwards, columns to the right.
+type Point struct {
+	Row, Col int
+}
+
+func (p Point) Move(d Dir) Point {
+	return Point{p.Row + d.Row, p.Col + d.Col}
+}
+
+func (p Point) MoveN(d Dir, n int) Point {
+	return Point{p.Row + d.Row*n, p.Col + d.Col*n}
+}
+
+func (p Point) Add(other Point) Point {
+	return Point{p.Row + other.Row, p.Col + other.Col}
+}
+
+func (p Point) Sub(other Point) Point {
+	return Point{p.Row - other.Row, p.Col - other.Col}
+}
+
+// Manhattan returns the taxicab distance between p and other.
+func (p Point) Manhattan(other Point) int {
+	return abs(p.Row-other.Row) + abs(p.Col-other.Col)
+}
+
+// Neighbors4 returns the orthogonally adjacent points, in the order of Dirs4.
+func (p Point) Neighbors4() []Point {
+	return p.neighbors(Dirs4)
+}
+
+// Neighbors8 returns the orthogonally and diagonally adjacent points, in the order of Dirs8.
+func (p Point) Neighbors8() []Point {
+	return p.neighbors(Dirs8)
+}
+
+func (p Point) neighbors(dirs []Dir) []Point {
+	result := make([]Point, len(dirs))
+	for i, d := range dirs {
+		result[i] = p.Move(d)
+	}
+	return result
+}
+
+// Dir is a single step on a grid.
+type Dir struct {
+	Row, Col int
+}
+
+var (
+	Up        = Dir{-1, 0}
+	Right     = Dir{0, 1}
+	Down      = Dir{1, 0}
+	Left      = Dir{0, -1}
+	UpRight   = Dir{-1, 1}
+	DownRight = Dir{1, 1}
+	DownLeft  = Dir{1, -1}
+	UpLeft    = Dir{-1, -1}
+)
+
+// Dirs4 lists the orthogonal directions clockwise, starting with Up.
+var Dirs4 = []Dir{Up, Right, Down, Left}
+
+// Dirs8 lists all eight directions clockwise, starting with Up.
+var Dirs8 = []Dir{Up, UpRight, Right, DownRight, Down, DownLeft, Left, UpLeft}
+
+// TurnRight rotates d by 90° clockwise.
+func (d Dir) TurnRight() Dir {
+	return Dir{d.Col, -d.Row}
+}
+
+// TurnLeft rotates d by 90° counterclockwise.
+func (d Dir) TurnLeft() Dir {
+	return Dir{-d.Col, d.Row}
+}
+
+func (d Dir) Reverse() Dir {
+	return Dir{-d.Row, -d.Col}
+}
+
+func (d Dir) IsHorizontal() bool {
+	return d.Row == 0
+}
+
+func (d Dir) IsVertical() bool {
+	return d.Col == 0
+}
+
+func (d Dir) String() string {
+	switch d {
+	case Up:
+		return "up"
+	case Right:
+		return "right"
+	case Down:
+		return "down"
+	case Left:
+		return "left"
+	case UpRight:
+		return "up-right"
+	case DownRight:
+		return "down-right"
+	case DownLeft:
+		return "down-left"
+	case UpLeft:
+		return "up-left"
+	}
+	return "none"
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
@@ -0,0 +1,88 @@
+package inputs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Stdin is the path that makes Load read the puzzle input from standard input.
+const Stdin = "-"
+
+// EnvDir names the environment variable holding the default inputs directory.
+const EnvDir = "AOC_INPUTS"
+
+// NotFoundError is returned when no input file exists for a day.
+type NotFoundError struct {
+	Day   int
+	Tried []string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("day %d: puzzle input not found (tried %s); use --input or --inputs-dir",
+		e.Day, strings.Join(e.Tried, ", "))
+}
+
+// DefaultDir returns the inputs directory from $AOC_INPUTS, or the current directory.
+func DefaultDir() string {
+	if dir := os.Getenv(EnvDir); dir != "" {
+		return dir
+	}
+	return "."
+}
+
+// Paths returns the candidate input files for a day within dir,
+// in the order they are tried: dir/dayNN/input.txt, then dir/dayNN.txt.
+func Paths(dir string, day int) []string {
+	name := fmt.Sprintf("day%02d", day)
+	return []string{
+		filepath.Join(dir, name, "input.txt"),
+		filepath.Join(dir, name+".txt"),
+	}
+}
+
+// Load returns the puzzle input of a day. If path is set it is read directly
+// (Stdin reads standard input), otherwise the day's file is looked up in dir.
+func Load(day int, path, dir string) (string, error) {
+	if path == Stdin {
+		content, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("day %d: reading stdin: %w", day, err)
+		}
+		return normalize(content), nil
+	}
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", &NotFoundError{day, []string{path}}
+		}
+		if err != nil {
+			return "", fmt.Errorf("day %d: %w", day, err)
+		}
+		return normalize(content), nil
+	}
+
+	paths := Paths(dir, day)
+	for _, p := range paths {
+		content, err := os.ReadFile(p)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("day %d: %w", day, err)
+		}
+		return normalize(content), nil
+	}
+	return "", &NotFoundError{day, paths}
+}
+
+// normalize converts line endings to \n and strips trailing newlines,
+// because the solvers split the input on \n and expect no empty last line.
+func normalize(content []byte) string {
+	s := strings.ReplaceAll(string(content), "\r\n", "\n")
+	return strings.TrimRight(s, "\n")
+}
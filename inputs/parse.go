@@ -0,0 +1,242 @@
+package inputs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseError reports malformed puzzle input.
+type ParseError struct {
+	Line int // 1-based
+	Col  int // 1-based byte offset within the line
+	// Token is the offending text, empty if something is missing.
+	Token string
+	Msg   string
+	// Source is the whole offending line.
+	Source string
+}
+
+func (e *ParseError) Error() string {
+	if e.Token == "" {
+		return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Col, e.Msg)
+	}
+	return fmt.Sprintf("line %d, column %d: %s %q", e.Line, e.Col, e.Msg, e.Token)
+}
+
+// Excerpt returns the offending line with a marker under the token.
+func (e *ParseError) Excerpt() string {
+	prefix := fmt.Sprintf("%5d | ", e.Line)
+	marker := strings.Repeat("^", max(len(e.Token), 1))
+	return fmt.Sprintf("%s%s\n%*s| %*s%s\n",
+		prefix, e.Source, len(prefix)-2, "", e.Col-1, "", marker)
+}
+
+// Report writes err to w, followed by the offending line if err is or
+// wraps a ParseError.
+func Report(w io.Writer, err error) {
+	fmt.Fprintln(w, err)
+	parseErr := &ParseError{}
+	if errors.As(err, &parseErr) {
+		fmt.Fprint(w, parseErr.Excerpt())
+	}
+}
+
+// Span is a piece of puzzle input that knows where it came from, so that
+// errors about it can point at the right line and column.
+type Span struct {
+	Text   string
+	Line   int // 1-based
+	Col    int // 1-based
+	source string
+}
+
+// Lines splits the puzzle input into lines.
+func Lines(s string) []Span {
+	lines := strings.Split(s, "\n")
+	spans := make([]Span, len(lines))
+	for i, line := range lines {
+		spans[i] = Span{line, i + 1, 1, line}
+	}
+	return spans
+}
+
+// Blocks splits the puzzle input into lines and groups them at empty lines.
+func Blocks(s string) [][]Span {
+	blocks := [][]Span{}
+	block := []Span{}
+	for _, line := range Lines(s) {
+		if line.Text == "" {
+			blocks = append(blocks, block)
+			block = []Span{}
+			continue
+		}
+		block = append(block, line)
+	}
+	return append(blocks, block)
+}
+
+// Errorf returns a ParseError pointing at the span.
+func (s Span) Errorf(format string, args ...any) error {
+	return &ParseError{s.Line, s.Col, s.Text, fmt.Sprintf(format, args...), s.source}
+}
+
+// MissingErrorf returns a ParseError pointing just behind the span, for
+// input that ends too early.
+func (s Span) MissingErrorf(format string, args ...any) error {
+	return &ParseError{s.Line, s.Col + len(s.Text), "", fmt.Sprintf(format, args...), s.source}
+}
+
+// Slice returns the span of s.Text[i:j].
+func (s Span) Slice(i, j int) Span {
+	return Span{s.Text[i:j], s.Line, s.Col + i, s.source}
+}
+
+// TrimSpace returns the span without leading and trailing white space.
+func (s Span) TrimSpace() Span {
+	start := len(s.Text) - len(strings.TrimLeftFunc(s.Text, unicode.IsSpace))
+	end := len(strings.TrimRightFunc(s.Text, unicode.IsSpace))
+	if start > end {
+		return s.Slice(end, end)
+	}
+	return s.Slice(start, end)
+}
+
+// Cut slices s around the first sep, like strings.Cut. It fails if sep is missing.
+func (s Span) Cut(sep string) (before, after Span, err error) {
+	i := strings.Index(s.Text, sep)
+	if i < 0 {
+		return s, Span{}, s.MissingErrorf("missing %q", sep)
+	}
+	return s.Slice(0, i), s.Slice(i+len(sep), len(s.Text)), nil
+}
+
+// Split slices s into all spans separated by sep, like strings.Split.
+func (s Span) Split(sep string) []Span {
+	parts := strings.Split(s.Text, sep)
+	spans := make([]Span, len(parts))
+	offset := 0
+	for i, part := range parts {
+		spans[i] = s.Slice(offset, offset+len(part))
+		offset += len(part) + len(sep)
+	}
+	return spans
+}
+
+// Fields splits s around runs of white space, like strings.Fields.
+func (s Span) Fields() []Span {
+	spans := []Span{}
+	start := -1
+	for i, r := range s.Text {
+		switch {
+		case unicode.IsSpace(r) && start >= 0:
+			spans = append(spans, s.Slice(start, i))
+			start = -1
+		case !unicode.IsSpace(r) && start < 0:
+			start = i
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, s.Slice(start, len(s.Text)))
+	}
+	return spans
+}
+
+// TrimPrefix removes prefix from s. It fails if s does not start with prefix.
+func (s Span) TrimPrefix(prefix string) (Span, error) {
+	if !strings.HasPrefix(s.Text, prefix) {
+		return s, s.Errorf("want %q, got", prefix)
+	}
+	return s.Slice(len(prefix), len(s.Text)), nil
+}
+
+// TrimSuffix removes suffix from s. It fails if s does not end with suffix.
+func (s Span) TrimSuffix(suffix string) (Span, error) {
+	if !strings.HasSuffix(s.Text, suffix) {
+		return s, s.Errorf("want suffix %q, got", suffix)
+	}
+	return s.Slice(0, len(s.Text)-len(suffix)), nil
+}
+
+// Int parses the span as decimal integer.
+func (s Span) Int() (int, error) {
+	n, err := strconv.Atoi(s.Text)
+	if err != nil {
+		return 0, s.number(err)
+	}
+	return n, nil
+}
+
+// Int64 parses the span as decimal 64-bit integer.
+func (s Span) Int64() (int64, error) {
+	n, err := strconv.ParseInt(s.Text, 10, 64)
+	if err != nil {
+		return 0, s.number(err)
+	}
+	return n, nil
+}
+
+// BigInt parses the span as a decimal integer of any size.
+func (s Span) BigInt() (*big.Int, error) {
+	n, ok := new(big.Int).SetString(s.Text, 10)
+	if !ok {
+		if s.Text == "" {
+			return nil, s.MissingErrorf("missing number")
+		}
+		return nil, s.Errorf("invalid number")
+	}
+	return n, nil
+}
+
+func (s Span) number(err error) error {
+	if s.Text == "" {
+		return s.MissingErrorf("missing number")
+	}
+	if err.(*strconv.NumError).Err == strconv.ErrRange {
+		return s.Errorf("number out of range")
+	}
+	return s.Errorf("invalid number")
+}
+
+// Ints parses the white space separated integers in s.
+func (s Span) Ints() ([]int, error) {
+	fields := s.Fields()
+	numbers := make([]int, len(fields))
+	for i, field := range fields {
+		n, err := field.Int()
+		if err != nil {
+			return nil, err
+		}
+		numbers[i] = n
+	}
+	return numbers, nil
+}
+
+// IntsSep splits s at sep and parses the parts, ignoring white space around
+// them, as integers.
+func (s Span) IntsSep(sep string) ([]int, error) {
+	parts := s.Split(sep)
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := part.TrimSpace().Int()
+		if err != nil {
+			return nil, err
+		}
+		numbers[i] = n
+	}
+	return numbers, nil
+}
+
+// Join returns the text of the lines joined with newlines, like the
+// puzzle input they were split from.
+func Join(lines []Span) string {
+	texts := make([]string, len(lines))
+	for i, line := range lines {
+		texts[i] = line.Text
+	}
+	return strings.Join(texts, "\n")
+}
@@ -0,0 +1,108 @@
+package inputs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSpanPositions(t *testing.T) {
+	line := Lines("first\nGame 12:  3 blue, x red")[1]
+	_, rest, err := line.Cut(":")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cubes := rest.Split(",")
+	fields := cubes[1].Fields()
+	tests := []struct {
+		span      Span
+		text      string
+		line, col int
+	}{
+		{rest, "  3 blue, x red", 2, 9},
+		{cubes[0].TrimSpace(), "3 blue", 2, 11},
+		{fields[0], "x", 2, 19},
+		{fields[1], "red", 2, 21},
+	}
+	for _, tt := range tests {
+		if tt.span.Text != tt.text || tt.span.Line != tt.line || tt.span.Col != tt.col {
+			t.Errorf("got %q at %d:%d, want %q at %d:%d",
+				tt.span.Text, tt.span.Line, tt.span.Col, tt.text, tt.line, tt.col)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	line := Lines("a\nsize: 1x, 2")[1]
+	tests := []struct {
+		name string
+		err  func() error
+		want string
+	}{
+		{"invalid number", func() error {
+			_, err := line.Slice(6, 11).IntsSep(",")
+			return err
+		}, `line 2, column 7: invalid number "1x"`},
+		{"out of range", func() error {
+			_, err := Lines("99999999999999999999")[0].Int64()
+			return err
+		}, `line 1, column 1: number out of range "99999999999999999999"`},
+		{"invalid big number", func() error {
+			_, err := line.Slice(6, 8).BigInt()
+			return err
+		}, `line 2, column 7: invalid number "1x"`},
+		{"missing separator", func() error {
+			_, _, err := line.Cut(";")
+			return err
+		}, `line 2, column 12: missing ";"`},
+		{"prefix", func() error {
+			_, err := line.TrimPrefix("length:")
+			return err
+		}, `line 2, column 1: want "length:", got "size: 1x, 2"`},
+	}
+	for _, tt := range tests {
+		err := tt.err()
+		parseErr := &ParseError{}
+		if !errors.As(err, &parseErr) {
+			t.Errorf("%s: got %v, want ParseError", tt.name, err)
+			continue
+		}
+		if err.Error() != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	_, err := Lines("a\nsize: 1x, 2")[1].Slice(6, 8).Int()
+	want := "    2 | size: 1x, 2\n      |       ^^\n"
+	if got := err.(*ParseError).Excerpt(); got != want {
+		t.Errorf("got\n%swant\n%s", got, want)
+	}
+}
+
+func TestReport(t *testing.T) {
+	_, err := Lines("a\nsize: 1x, 2")[1].Slice(6, 8).Int()
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{fmt.Errorf("day 2: %w", err), "day 2: line 2, column 7: invalid number \"1x\"\n    2 | size: 1x, 2\n      |       ^^\n"},
+		{errors.New("no input"), "no input\n"},
+	}
+	for _, tt := range tests {
+		w := &strings.Builder{}
+		Report(w, tt.err)
+		if got := w.String(); got != tt.want {
+			t.Errorf("got\n%swant\n%s", got, tt.want)
+		}
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	blocks := Blocks("a\nb\n\nc")
+	if len(blocks) != 2 || len(blocks[0]) != 2 || blocks[1][0].Text != "c" || blocks[1][0].Line != 4 {
+		t.Errorf("Blocks = %v, want [a b] and [c] on line 4", blocks)
+	}
+}
@@ -0,0 +1,114 @@
+// Package interval provides half-open integer intervals and operations on
+// sets of them.
+package interval
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Interval is the set of integers x with Start <= x < End. It is empty
+// if End <= Start.
+type Interval struct {
+	Start, End int
+}
+
+// Of returns the interval of length numbers from start.
+func Of(start, length int) Interval {
+	return Interval{start, start + length}
+}
+
+func (i Interval) String() string {
+	return fmt.Sprintf("[%d,%d)", i.Start, i.End)
+}
+
+func (i Interval) Empty() bool { return i.End <= i.Start }
+
+// Len returns the number of integers in the interval.
+func (i Interval) Len() int {
+	if i.Empty() {
+		return 0
+	}
+	return i.End - i.Start
+}
+
+func (i Interval) Contains(x int) bool {
+	return i.Start <= x && x < i.End
+}
+
+// Shift returns the interval moved by offset.
+func (i Interval) Shift(offset int) Interval {
+	return Interval{i.Start + offset, i.End + offset}
+}
+
+// Intersect returns the numbers in both intervals; the result may be empty.
+func (i Interval) Intersect(other Interval) Interval {
+	return Interval{max(i.Start, other.Start), min(i.End, other.End)}
+}
+
+// Overlaps reports whether the intervals have a number in common.
+func (i Interval) Overlaps(other Interval) bool {
+	return !i.Intersect(other).Empty()
+}
+
+// Split cuts the interval into the numbers below at and the rest; either
+// part may be empty.
+func (i Interval) Split(at int) (below, rest Interval) {
+	at = min(max(at, i.Start), i.End)
+	return Interval{i.Start, at}, Interval{at, i.End}
+}
+
+// Subtract returns the at most two non-empty intervals of numbers in i but
+// not in other.
+func (i Interval) Subtract(other Interval) []Interval {
+	if i.Empty() {
+		return nil
+	}
+	if !i.Overlaps(other) {
+		return []Interval{i}
+	}
+	result := []Interval{}
+	if i.Start < other.Start {
+		result = append(result, Interval{i.Start, other.Start})
+	}
+	if other.End < i.End {
+		result = append(result, Interval{other.End, i.End})
+	}
+	return result
+}
+
+// Merge returns the union of the intervals as sorted, non-empty and
+// non-adjacent intervals.
+func Merge(intervals []Interval) []Interval {
+	sorted := []Interval{}
+	for _, i := range intervals {
+		if !i.Empty() {
+			sorted = append(sorted, i)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Interval) int { return a.Start - b.Start })
+
+	result := []Interval{}
+	for _, i := range sorted {
+		if last := len(result) - 1; last >= 0 && i.Start <= result[last].End {
+			result[last].End = max(result[last].End, i.End)
+			continue
+		}
+		result = append(result, i)
+	}
+	return result
+}
+
+// SubtractAll returns the numbers in the intervals but in none of the
+// removed ones, merged.
+func SubtractAll(intervals, removed []Interval) []Interval {
+	remaining := Merge(intervals)
+	for _, r := range removed {
+		next := []Interval{}
+		for _, i := range remaining {
+			next = append(next, i.Subtract(r)...)
+		}
+		remaining = next
+	}
+	return remaining
+}
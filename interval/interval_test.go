@@ -0,0 +1,46 @@
+package interval
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSetOperations(t *testing.T) {
+	i := Interval{10, 20}
+	tests := []struct {
+		name string
+		got  []Interval
+		want []Interval
+	}{
+		{"subtract middle", i.Subtract(Interval{12, 15}), []Interval{{10, 12}, {15, 20}}},
+		{"subtract left", i.Subtract(Interval{0, 15}), []Interval{{15, 20}}},
+		{"subtract all", i.Subtract(Interval{0, 30}), []Interval{}},
+		{"subtract disjoint", i.Subtract(Interval{20, 30}), []Interval{{10, 20}}},
+		{"merge", Merge([]Interval{{5, 7}, {1, 3}, {3, 4}, {6, 9}, {8, 8}}), []Interval{{1, 4}, {5, 9}}},
+		{"subtract all of set", SubtractAll([]Interval{{0, 10}, {20, 30}}, []Interval{{5, 25}, {28, 29}}),
+			[]Interval{{0, 5}, {25, 28}, {29, 30}}},
+	}
+	for _, tt := range tests {
+		if !slices.Equal(tt.got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestIntersectAndSplit(t *testing.T) {
+	i := Of(10, 10)
+	if got := i.Intersect(Interval{15, 30}); got != (Interval{15, 20}) {
+		t.Errorf("Intersect = %v, want [15,20)", got)
+	}
+	if got := i.Intersect(Interval{25, 30}); !got.Empty() || got.Len() != 0 {
+		t.Errorf("Intersect of disjoint intervals = %v, want empty", got)
+	}
+	below, rest := i.Split(13)
+	if below != (Interval{10, 13}) || rest != (Interval{13, 20}) {
+		t.Errorf("Split(13) = %v, %v", below, rest)
+	}
+	below, rest = i.Split(50)
+	if below != i || !rest.Empty() {
+		t.Errorf("Split(50) = %v, %v", below, rest)
+	}
+}
@@ -0,0 +1,231 @@
+// Package linalg solves systems of linear equations, either exactly with
+// big.Rat or approximately with float64, using Gaussian elimination with
+// partial pivoting.
+package linalg
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// ErrSingular is returned when a system has no unique solution.
+var ErrSingular = errors.New("linalg: matrix is singular")
+
+// Rats converts a matrix of integers to rationals.
+func Rats(a [][]int64) [][]*big.Rat {
+	result := make([][]*big.Rat, len(a))
+	for i := range a {
+		result[i] = RatVector(a[i]...)
+	}
+	return result
+}
+
+// RatVector converts integers to rationals.
+func RatVector(v ...int64) []*big.Rat {
+	result := make([]*big.Rat, len(v))
+	for i := range v {
+		result[i] = big.NewRat(v[i], 1)
+	}
+	return result
+}
+
+// SolveRat solves a·x = b exactly. a has one row per equation; with more
+// equations than unknowns the least-squares solution is returned, which is
+// the exact one if the system is consistent.
+func SolveRat(a [][]*big.Rat, b []*big.Rat) ([]*big.Rat, error) {
+	if err := checkShape(len(a), len(b)); err != nil {
+		return nil, err
+	}
+	if len(a) > len(a[0]) {
+		return LeastSquaresRat(a, b)
+	}
+	m := augmentRat(a, b)
+	n := len(a[0])
+	if rank := eliminateRat(m, n); rank < n {
+		return nil, fmt.Errorf("%w: rank %d < %d unknowns", ErrSingular, rank, n)
+	}
+
+	x := make([]*big.Rat, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := new(big.Rat).Set(m[i][n])
+		term := new(big.Rat)
+		for j := i + 1; j < n; j++ {
+			sum.Sub(sum, term.Mul(m[i][j], x[j]))
+		}
+		x[i] = sum.Quo(sum, m[i][i])
+	}
+	return x, nil
+}
+
+// LeastSquaresRat solves the normal equations aᵀa·x = aᵀb exactly.
+func LeastSquaresRat(a [][]*big.Rat, b []*big.Rat) ([]*big.Rat, error) {
+	if err := checkShape(len(a), len(b)); err != nil {
+		return nil, err
+	}
+	n := len(a[0])
+	ata := make([][]*big.Rat, n)
+	atb := make([]*big.Rat, n)
+	term := new(big.Rat)
+	for i := 0; i < n; i++ {
+		ata[i] = make([]*big.Rat, n)
+		for j := 0; j < n; j++ {
+			ata[i][j] = new(big.Rat)
+			for k := range a {
+				ata[i][j].Add(ata[i][j], term.Mul(a[k][i], a[k][j]))
+			}
+		}
+		atb[i] = new(big.Rat)
+		for k := range a {
+			atb[i].Add(atb[i], term.Mul(a[k][i], b[k]))
+		}
+	}
+	return SolveRat(ata, atb)
+}
+
+// RankRat returns the rank of a.
+func RankRat(a [][]*big.Rat) int {
+	if len(a) == 0 {
+		return 0
+	}
+	return eliminateRat(augmentRat(a, nil), len(a[0]))
+}
+
+// augmentRat copies a and appends b as last column, if given.
+func augmentRat(a [][]*big.Rat, b []*big.Rat) [][]*big.Rat {
+	m := make([][]*big.Rat, len(a))
+	for i := range a {
+		m[i] = make([]*big.Rat, len(a[i]), len(a[i])+1)
+		for j := range a[i] {
+			m[i][j] = new(big.Rat).Set(a[i][j])
+		}
+		if b != nil {
+			m[i] = append(m[i], new(big.Rat).Set(b[i]))
+		}
+	}
+	return m
+}
+
+// eliminateRat brings the first unknowns columns of m into row echelon
+// form and returns their rank. Pivot rows are swapped into place, so for a
+// full rank square system m[i][i] is the pivot of row i.
+func eliminateRat(m [][]*big.Rat, unknowns int) int {
+	rank := 0
+	ratio := new(big.Rat)
+	term := new(big.Rat)
+	for col := 0; col < unknowns && rank < len(m); col++ {
+		pivot := -1
+		for row := rank; row < len(m); row++ {
+			if m[row][col].Sign() != 0 && (pivot < 0 || absCmp(m[row][col], m[pivot][col]) > 0) {
+				pivot = row
+			}
+		}
+		if pivot < 0 {
+			continue
+		}
+		m[rank], m[pivot] = m[pivot], m[rank]
+		for row := rank + 1; row < len(m); row++ {
+			if m[row][col].Sign() == 0 {
+				continue
+			}
+			ratio.Quo(m[row][col], m[rank][col])
+			for k := col; k < len(m[row]); k++ {
+				m[row][k].Sub(m[row][k], term.Mul(ratio, m[rank][k]))
+			}
+		}
+		rank++
+	}
+	return rank
+}
+
+func absCmp(a, b *big.Rat) int {
+	return new(big.Rat).Abs(a).Cmp(new(big.Rat).Abs(b))
+}
+
+// Solve solves a·x = b in floating point; see SolveRat.
+func Solve(a [][]float64, b []float64) ([]float64, error) {
+	if err := checkShape(len(a), len(b)); err != nil {
+		return nil, err
+	}
+	if len(a) > len(a[0]) {
+		return LeastSquares(a, b)
+	}
+	n := len(a[0])
+	if len(a) < n {
+		return nil, fmt.Errorf("%w: %d equations < %d unknowns", ErrSingular, len(a), n)
+	}
+	m := make([][]float64, len(a))
+	scale := 0.0
+	for i := range a {
+		m[i] = append(append(make([]float64, 0, n+1), a[i]...), b[i])
+		for _, value := range a[i] {
+			scale = math.Max(scale, math.Abs(value))
+		}
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < len(m); row++ {
+			if math.Abs(m[row][col]) > math.Abs(m[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(m[pivot][col]) <= epsilon*scale {
+			return nil, fmt.Errorf("%w: no pivot in column %d", ErrSingular, col)
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+		for row := col + 1; row < len(m); row++ {
+			ratio := m[row][col] / m[col][col]
+			for k := col; k <= n; k++ {
+				m[row][k] -= ratio * m[col][k]
+			}
+		}
+	}
+
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		x[i] = m[i][n]
+		for j := i + 1; j < n; j++ {
+			x[i] -= m[i][j] * x[j]
+		}
+		x[i] /= m[i][i]
+	}
+	return x, nil
+}
+
+// epsilon is the magnitude, relative to the largest coefficient, below
+// which a float pivot counts as zero.
+const epsilon = 1e-12
+
+// LeastSquares solves the normal equations aᵀa·x = aᵀb in floating point.
+func LeastSquares(a [][]float64, b []float64) ([]float64, error) {
+	if err := checkShape(len(a), len(b)); err != nil {
+		return nil, err
+	}
+	n := len(a[0])
+	ata := make([][]float64, n)
+	atb := make([]float64, n)
+	for i := 0; i < n; i++ {
+		ata[i] = make([]float64, n)
+		for j := 0; j < n; j++ {
+			for k := range a {
+				ata[i][j] += a[k][i] * a[k][j]
+			}
+		}
+		for k := range a {
+			atb[i] += a[k][i] * b[k]
+		}
+	}
+	return Solve(ata, atb)
+}
+
+func checkShape(rows, rhs int) error {
+	if rows == 0 {
+		return errors.New("linalg: empty system")
+	}
+	if rows != rhs {
+		return fmt.Errorf("linalg: %d equations but %d right-hand sides", rows, rhs)
+	}
+	return nil
+}
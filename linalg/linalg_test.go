@@ -0,0 +1,61 @@
+package linalg
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestSolveRat(t *testing.T) {
+	tests := []struct {
+		name string
+		a    [][]int64
+		b    []int64
+		want []*big.Rat
+	}{
+		{"zero on diagonal", [][]int64{{0, 1}, {1, 0}}, []int64{2, 3}, RatVector(3, 2)},
+		{"fractions", [][]int64{{2, 1}, {1, 3}}, []int64{1, 1}, []*big.Rat{big.NewRat(2, 5), big.NewRat(1, 5)}},
+		{"quadratic fit", [][]int64{{1, 1, 1}, {4, 2, 1}, {9, 3, 1}}, []int64{6, 11, 18}, RatVector(1, 2, 3)},
+		{"overdetermined consistent", [][]int64{{1, 0}, {0, 1}, {1, 1}}, []int64{4, 5, 9}, RatVector(4, 5)},
+		{"overdetermined inconsistent", [][]int64{{1}, {1}}, []int64{1, 2}, []*big.Rat{big.NewRat(3, 2)}},
+	}
+	for _, tt := range tests {
+		got, err := SolveRat(Rats(tt.a), RatVector(tt.b...))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		for i := range tt.want {
+			if got[i].Cmp(tt.want[i]) != 0 {
+				t.Errorf("%s: x[%d] = %v, want %v", tt.name, i, got[i], tt.want[i])
+			}
+		}
+	}
+}
+
+func TestSingular(t *testing.T) {
+	a := [][]int64{{1, 2}, {2, 4}}
+	if _, err := SolveRat(Rats(a), RatVector(1, 2)); !errors.Is(err, ErrSingular) {
+		t.Errorf("SolveRat: got %v, want ErrSingular", err)
+	}
+	if _, err := Solve([][]float64{{1, 2}, {2, 4}}, []float64{1, 2}); !errors.Is(err, ErrSingular) {
+		t.Errorf("Solve: got %v, want ErrSingular", err)
+	}
+	if got := RankRat(Rats(a)); got != 1 {
+		t.Errorf("RankRat = %d, want 1", got)
+	}
+	if got := RankRat(Rats([][]int64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})); got != 2 {
+		t.Errorf("RankRat = %d, want 2", got)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	got, err := Solve([][]float64{{0, 2}, {3, 1}}, []float64{4, 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(got[0]-1) > 1e-9 || math.Abs(got[1]-2) > 1e-9 {
+		t.Errorf("Solve = %v, want [1 2]", got)
+	}
+}
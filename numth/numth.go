@@ -0,0 +1,181 @@
+// Package numth provides the number theory behind puzzles about cycles:
+// greatest common divisors, least common multiples, modular inverses and
+// systems of congruences.
+package numth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var (
+	// ErrOverflow is returned when a result does not fit into an int.
+	ErrOverflow = errors.New("numth: result overflows int")
+	// ErrNoInverse is returned by ModInverse for numbers sharing a factor with the modulus.
+	ErrNoInverse = errors.New("numth: no modular inverse")
+	// ErrNoSolution is returned by CRT for contradicting congruences.
+	ErrNoSolution = errors.New("numth: congruences have no common solution")
+)
+
+// GCD returns the non-negative greatest common divisor of a and b, using
+// Euclid's algorithm. GCD(0, 0) is 0.
+func GCD(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return abs(a)
+}
+
+// GCDAll returns the greatest common divisor of all numbers.
+func GCDAll(nums ...int) int {
+	result := 0
+	for _, n := range nums {
+		result = GCD(result, n)
+	}
+	return result
+}
+
+// LCM returns the non-negative least common multiple of all numbers, which
+// is 0 if any of them is 0. It returns ErrOverflow if the result does not
+// fit into an int; LCMBig computes it exactly.
+func LCM(nums ...int) (int, error) {
+	result := 1
+	for _, n := range nums {
+		if n == 0 {
+			return 0, nil
+		}
+		multiple, ok := mul(result/GCD(result, n), abs(n))
+		if !ok {
+			return 0, fmt.Errorf("%w: lcm of %v is %v", ErrOverflow, nums, LCMBig(nums...))
+		}
+		result = multiple
+	}
+	return result, nil
+}
+
+// LCMBig returns the least common multiple of all numbers exactly.
+func LCMBig(nums ...int) *big.Int {
+	result := big.NewInt(1)
+	g := new(big.Int)
+	for _, n := range nums {
+		bn := big.NewInt(int64(n))
+		bn.Abs(bn)
+		if bn.Sign() == 0 {
+			return bn
+		}
+		g.GCD(nil, nil, result, bn)
+		result.Mul(result.Quo(result, g), bn)
+	}
+	return result
+}
+
+// ExtendedGCD returns g = GCD(a, b) and Bézout coefficients x and y with
+// a*x + b*y = g.
+func ExtendedGCD(a, b int) (g, x, y int) {
+	oldR, r := a, b
+	oldX, x := 1, 0
+	oldY, y := 0, 1
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldX, x = x, oldX-q*x
+		oldY, y = y, oldY-q*y
+	}
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// ModInverse returns x in [0, m) with a*x ≡ 1 (mod m).
+func ModInverse(a, m int) (int, error) {
+	if m <= 0 {
+		return 0, fmt.Errorf("numth: modulus %d is not positive", m)
+	}
+	g, x, _ := ExtendedGCD(Mod(a, m), m)
+	if g != 1 {
+		return 0, fmt.Errorf("%w: %d shares the factor %d with %d", ErrNoInverse, a, g, m)
+	}
+	return Mod(x, m), nil
+}
+
+// Mod returns a modulo m in [0, m), also for negative a.
+func Mod(a, m int) int {
+	result := a % m
+	if result < 0 {
+		result += m
+	}
+	return result
+}
+
+// Congruence is the set of numbers x ≡ Residue (mod Modulus).
+type Congruence struct {
+	Residue, Modulus int
+}
+
+func (c Congruence) String() string {
+	return fmt.Sprintf("x ≡ %d (mod %d)", c.Residue, c.Modulus)
+}
+
+// CRT combines congruences into a single one that holds exactly for the
+// numbers satisfying all of them, by the Chinese Remainder Theorem. The
+// moduli need not be coprime: the combined modulus is their least common
+// multiple, and ErrNoSolution is returned if the congruences contradict
+// each other. The combined residue is in [0, Modulus). Intermediate values
+// are computed exactly; ErrOverflow is returned if the combined modulus
+// does not fit into an int.
+func CRT(congruences ...Congruence) (Congruence, error) {
+	residue, modulus := big.NewInt(0), big.NewInt(1)
+	for _, c := range congruences {
+		if c.Modulus <= 0 {
+			return Congruence{}, fmt.Errorf("numth: modulus %d is not positive", c.Modulus)
+		}
+		if err := merge(residue, modulus, big.NewInt(int64(c.Residue)), big.NewInt(int64(c.Modulus))); err != nil {
+			return Congruence{}, fmt.Errorf("%w: %v", err, congruences)
+		}
+	}
+	if !modulus.IsInt64() || int64(int(modulus.Int64())) != modulus.Int64() {
+		return Congruence{}, fmt.Errorf("%w: combined modulus is %v", ErrOverflow, modulus)
+	}
+	return Congruence{int(residue.Int64()), int(modulus.Int64())}, nil
+}
+
+// merge updates x ≡ r1 (mod m1) in place to the congruence that also
+// satisfies x ≡ r2 (mod m2).
+//
+// x = r1 + m1*k solves the second congruence iff m1*k ≡ r2 - r1 (mod m2),
+// which needs g = gcd(m1, m2) to divide r2 - r1 and then gives
+// k ≡ (r2-r1)/g * (m1/g)⁻¹ (mod m2/g).
+func merge(r1, m1, r2, m2 *big.Int) error {
+	g := new(big.Int).GCD(nil, nil, m1, m2)
+	diff := new(big.Int).Sub(r2, r1)
+	quotient, remainder := new(big.Int).QuoRem(diff, g, new(big.Int))
+	if remainder.Sign() != 0 {
+		return ErrNoSolution
+	}
+	reduced := new(big.Int).Quo(m2, g)
+	k := new(big.Int).Quo(m1, g)
+	k.ModInverse(k, reduced)
+	k.Mul(k, quotient).Mod(k, reduced)
+
+	r1.Add(r1, k.Mul(k, m1))
+	m1.Mul(m1, reduced)
+	r1.Mod(r1, m1)
+	return nil
+}
+
+func mul(a, b int) (int, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	product := a * b
+	return product, product/b == a
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
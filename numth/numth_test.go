@@ -0,0 +1,78 @@
+package numth
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestGCD(t *testing.T) {
+	tests := []struct{ a, b, want int }{
+		{0, 0, 0},
+		{0, 7, 7},
+		{12, 18, 6},
+		{-12, 18, 6},
+		{1, 1 << 62, 1},
+		{20221, 13201, 1},
+	}
+	for _, tt := range tests {
+		if got := GCD(tt.a, tt.b); got != tt.want {
+			t.Errorf("GCD(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		g, x, y := ExtendedGCD(tt.a, tt.b)
+		if g != tt.want || tt.a*x+tt.b*y != g {
+			t.Errorf("ExtendedGCD(%d, %d) = %d, %d, %d", tt.a, tt.b, g, x, y)
+		}
+	}
+	if got := GCDAll(12, 18, 27); got != 3 {
+		t.Errorf("GCDAll = %d, want 3", got)
+	}
+}
+
+func TestLCM(t *testing.T) {
+	if got, err := LCM(4, 6, -10); got != 60 || err != nil {
+		t.Errorf("LCM(4, 6, -10) = %d, %v; want 60", got, err)
+	}
+	if got, err := LCM(3, 0); got != 0 || err != nil {
+		t.Errorf("LCM(3, 0) = %d, %v; want 0", got, err)
+	}
+
+	primes := []int{1000000007, 1000000009, 998244353}
+	_, err := LCM(primes...)
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("LCM of three large primes: got %v, want ErrOverflow", err)
+	}
+	if got, want := LCMBig(primes...).String(), "998244368971909710889394239"; got != want {
+		t.Errorf("LCMBig = %s, want %s", got, want)
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	if got, err := ModInverse(-3, 7); got != 2 || err != nil {
+		t.Errorf("ModInverse(-3, 7) = %d, %v; want 2", got, err)
+	}
+	if _, err := ModInverse(6, 9); !errors.Is(err, ErrNoInverse) {
+		t.Errorf("ModInverse(6, 9): got %v, want ErrNoInverse", err)
+	}
+}
+
+func TestCRT(t *testing.T) {
+	tests := []struct {
+		congruences []Congruence
+		want        Congruence
+		err         error
+	}{
+		{[]Congruence{{2, 3}, {3, 5}, {2, 7}}, Congruence{23, 105}, nil},
+		{[]Congruence{{-1, 4}, {5, 6}}, Congruence{11, 12}, nil},
+		{[]Congruence{{0, 13201}, {0, 20221}}, Congruence{0, 13201 * 20221}, nil},
+		{[]Congruence{{1, 4}, {2, 6}}, Congruence{}, ErrNoSolution},
+		{[]Congruence{{0, math.MaxInt / 2}, {0, math.MaxInt/2 - 1}}, Congruence{}, ErrOverflow},
+		{nil, Congruence{0, 1}, nil},
+	}
+	for _, tt := range tests {
+		got, err := CRT(tt.congruences...)
+		if !errors.Is(err, tt.err) || got != tt.want {
+			t.Errorf("CRT(%v) = %v, %v; want %v, %v", tt.congruences, got, err, tt.want, tt.err)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Package sequence fits polynomials to sequences of values at the indices
+// 0, 1, 2, ... and predicts the values at other indices exactly.
+package sequence
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrNotPolynomial is returned by Fit for sequences whose differences
+// never become a row of zeros: no polynomial of a degree low enough to be
+// checked against the values fits them.
+var ErrNotPolynomial = errors.New("sequence: differences never become zero")
+
+// Polynomial is the polynomial of minimal degree through the values of a
+// sequence, kept in Newton's forward difference form:
+//
+//	p(x) = Σ Δᵏp(0) · C(x, k)
+//
+// where C(x, k) = x(x-1)...(x-k+1) / k! is defined for every integer x.
+type Polynomial struct {
+	// differences[k] is the k-th forward difference at index 0.
+	differences []*big.Rat
+}
+
+// Fit returns the polynomial through the integer values.
+func Fit(values []int) (Polynomial, error) {
+	rats := make([]*big.Rat, len(values))
+	for i, value := range values {
+		rats[i] = new(big.Rat).SetInt64(int64(value))
+	}
+	return FitRat(rats)
+}
+
+// FitRat returns the polynomial through the values. The degree d is found
+// by taking differences until a row is all zeros, which takes at least
+// d+2 values; ErrNotPolynomial is returned if there are too few.
+func FitRat(values []*big.Rat) (Polynomial, error) {
+	row := make([]*big.Rat, len(values))
+	for i, value := range values {
+		row[i] = new(big.Rat).Set(value)
+	}
+	differences := []*big.Rat{}
+	for len(row) > 0 {
+		if allZero(row) {
+			return Polynomial{differences}, nil
+		}
+		differences = append(differences, row[0])
+		for i := 0; i < len(row)-1; i++ {
+			// row[i] is not needed after this
+			row[i] = new(big.Rat).Sub(row[i+1], row[i])
+		}
+		row = row[:len(row)-1]
+	}
+	return Polynomial{}, fmt.Errorf("%w: %d values", ErrNotPolynomial, len(values))
+}
+
+func allZero(row []*big.Rat) bool {
+	for _, value := range row {
+		if value.Sign() != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Degree returns the degree of the polynomial; the zero polynomial has
+// degree -1.
+func (p Polynomial) Degree() int {
+	return len(p.differences) - 1
+}
+
+// At returns the value of the polynomial at the index, which may lie far
+// before or after the fitted values.
+func (p Polynomial) At(x int) *big.Rat {
+	result := new(big.Rat)
+	binomial := big.NewRat(1, 1) // C(x, k)
+	term := new(big.Rat)
+	for k, difference := range p.differences {
+		result.Add(result, term.Mul(difference, binomial))
+		// C(x, k+1) = C(x, k) · (x-k) / (k+1)
+		binomial.Mul(binomial, big.NewRat(int64(x-k), int64(k+1)))
+	}
+	return result
+}
+
+// String returns the polynomial in Newton form, like "3 + 3·C(x,1)".
+func (p Polynomial) String() string {
+	if len(p.differences) == 0 {
+		return "0"
+	}
+	s := ""
+	for k, difference := range p.differences {
+		if difference.Sign() == 0 {
+			continue
+		}
+		if s != "" {
+			s += " + "
+		}
+		if k == 0 {
+			s += difference.RatString()
+		} else {
+			s += fmt.Sprintf("%s·C(x,%d)", difference.RatString(), k)
+		}
+	}
+	return s
+}
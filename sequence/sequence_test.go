@@ -0,0 +1,61 @@
+package sequence
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		values []int
+		degree int
+		at     map[int]int64
+	}{
+		{[]int{0, 3, 6, 9, 12, 15}, 1, map[int]int64{6: 18, -1: -3}},
+		{[]int{1, 3, 6, 10, 15, 21}, 2, map[int]int64{6: 28, -1: 0}},
+		{[]int{10, 13, 16, 21, 30, 45}, 3, map[int]int64{6: 68, -1: 5}},
+		{[]int{7, 7, 7}, 0, map[int]int64{1000: 7}},
+		{[]int{0, 0}, -1, map[int]int64{5: 0}},
+		// x³ - 2x far away from the values
+		{[]int{0, -1, 4, 21, 56}, 3, map[int]int64{1_000_000: 999_999_999_998_000_000, -1000: -999_998_000}},
+	}
+	for _, tt := range tests {
+		p, err := Fit(tt.values)
+		if err != nil {
+			t.Errorf("%v: %v", tt.values, err)
+			continue
+		}
+		if p.Degree() != tt.degree {
+			t.Errorf("%v: degree %d, want %d", tt.values, p.Degree(), tt.degree)
+		}
+		for x, want := range tt.at {
+			if got := p.At(x); got.Cmp(new(big.Rat).SetInt64(want)) != 0 {
+				t.Errorf("%v: At(%d) = %s, want %d", tt.values, x, got.RatString(), want)
+			}
+		}
+	}
+}
+
+func TestFitRat(t *testing.T) {
+	// x²/2 at 0, 1, 2, 3
+	values := []*big.Rat{big.NewRat(0, 1), big.NewRat(1, 2), big.NewRat(2, 1), big.NewRat(9, 2)}
+	p, err := FitRat(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := p.At(5), big.NewRat(25, 2); got.Cmp(want) != 0 {
+		t.Errorf("At(5) = %s, want %s", got.RatString(), want.RatString())
+	}
+	if got := p.String(); got != "1/2·C(x,1) + 1·C(x,2)" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestNotPolynomial(t *testing.T) {
+	for _, values := range [][]int{{1, 2, 4, 8, 16}, {5}, {}} {
+		if _, err := Fit(values); !errors.Is(err, ErrNotPolynomial) {
+			t.Errorf("%v: got %v, want ErrNotPolynomial", values, err)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package solver
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"golang.org/x/exp/maps"
+)
+
+// Solver solves both parts of one day's puzzle, given the puzzle text.
+// Malformed puzzle text is reported as *inputs.ParseError.
+type Solver interface {
+	Part1(input string) (any, error)
+	Part2(input string) (any, error)
+}
+
+// New returns a Solver that delegates to the given part functions.
+func New[T any](part1, part2 func(input string) (T, error)) Solver {
+	return funcs[T]{part1, part2}
+}
+
+type funcs[T any] struct {
+	part1, part2 func(input string) (T, error)
+}
+
+func (f funcs[T]) Part1(input string) (any, error) { return f.part1(input) }
+func (f funcs[T]) Part2(input string) (any, error) { return f.part2(input) }
+
+// Parser is implemented by solvers that parse the input once and solve
+// both parts from the result, so parsing can be measured on its own.
+type Parser interface {
+	Solver
+	Parse(input string) (any, error)
+	// SolveParsed solves the given part from a result of Parse.
+	// It must not modify parsed.
+	SolveParsed(part int, parsed any) (any, error)
+}
+
+// NewParsed returns a Parser that parses the input with parse and passes
+// the result to the given part functions.
+func NewParsed[P, T any](parse func(input string) (P, error), part1, part2 func(parsed P) (T, error)) Parser {
+	return parsedFuncs[P, T]{parse, part1, part2}
+}
+
+type parsedFuncs[P, T any] struct {
+	parse        func(input string) (P, error)
+	part1, part2 func(parsed P) (T, error)
+}
+
+func (f parsedFuncs[P, T]) Part1(input string) (any, error) { return f.solve(1, input) }
+func (f parsedFuncs[P, T]) Part2(input string) (any, error) { return f.solve(2, input) }
+func (f parsedFuncs[P, T]) Parse(input string) (any, error) { return f.parse(input) }
+
+func (f parsedFuncs[P, T]) solve(part int, input string) (any, error) {
+	parsed, err := f.parse(input)
+	if err != nil {
+		return nil, err
+	}
+	return f.SolveParsed(part, parsed)
+}
+
+func (f parsedFuncs[P, T]) SolveParsed(part int, parsed any) (any, error) {
+	if part == 1 {
+		return f.part1(parsed.(P))
+	}
+	return f.part2(parsed.(P))
+}
+
+// Debug receives diagnostic output of the solvers.
+// It discards everything unless the runner is started with --debug.
+var Debug io.Writer = io.Discard
+
+var registry = make(map[int]Solver)
+
+// Register makes a solver available for the given day.
+// It panics if a solver for that day is already registered.
+func Register(day int, s Solver) {
+	if _, ok := registry[day]; ok {
+		panic(fmt.Sprintf("solver: day %d registered twice", day))
+	}
+	registry[day] = s
+}
+
+// Get returns the solver registered for the given day.
+func Get(day int) (Solver, bool) {
+	s, ok := registry[day]
+	return s, ok
+}
+
+// Days returns all registered days in ascending order.
+func Days() []int {
+	days := maps.Keys(registry)
+	slices.Sort(days)
+	return days
+}